@@ -12,6 +12,8 @@ type Program struct {
     functions 	[]*Function
 	id 			string
 	vars 		[]*directories.VarEntry
+	decls 		[]*Vars
+	structs 	[]*StructDec
 }
 
 func (p *Program) Functions() []*Function {
@@ -26,6 +28,51 @@ func (p *Program) Vars() []*directories.VarEntry {
 	return p.vars
 }
 
+// Decls are the declarations of the globals in the order of the source, with their initializers
+func (p *Program) Decls() []*Vars {
+	return p.decls
+}
+
+func (p *Program) Structs() []*StructDec {
+	return p.structs
+}
+
+// StructDec is the node of a struct or class declaration, which holds its fields in declaration order.
+// Only classes have methods and embed an object
+type StructDec struct {
+	id 			string
+	fields 		[]*directories.VarEntry
+	methods 	[]*Function
+	embed 		*types.Type
+	class 		bool
+	tok 		*token.Token
+}
+
+func (s *StructDec) Id() string {
+	return s.id
+}
+
+func (s *StructDec) Fields() []*directories.VarEntry {
+	return s.fields
+}
+
+func (s *StructDec) Methods() []*Function {
+	return s.methods
+}
+
+func (s *StructDec) Embed() *types.Type {
+	return s.embed
+}
+
+// IsClass tells if it was declared with class instead of struct
+func (s *StructDec) IsClass() bool {
+	return s.class
+}
+
+func (s *StructDec) Token() *token.Token {
+	return s.tok
+}
+
 /*
 
 type Object struct {
@@ -93,6 +140,7 @@ type Statement interface {
 type Factor struct {
 	exp 	*Expression
 	cv 		Constant	
+	unary 	*Unary
 	tok 	*token.Token
 }
 
@@ -104,10 +152,33 @@ func (f *Factor) Constant() Constant {
 	return f.cv
 }
 
+func (f *Factor) Unary() *Unary {
+	return f.unary
+}
+
 func (f *Factor) Token() *token.Token {
 	return f.tok
 }
 
+// Unary is a prefix operation on a factor, ! or -
+type Unary struct {
+	op 		string
+	fac 	*Factor
+	tok 	*token.Token
+}
+
+func (u *Unary) Operation() string {
+	return u.op
+}
+
+func (u *Unary) Factor() *Factor {
+	return u.fac
+}
+
+func (u *Unary) Token() *token.Token {
+	return u.tok
+}
+
 type Term struct {
 	facs 	[]*Factor
 	ops		[]string
@@ -144,14 +215,15 @@ func (e *Exp) Token() *token.Token {
 	return e.tok
 }
 
+// Expression is the logical or of AndExps, the level of lowest precedence
 type Expression struct {
-	exps 	[]*Exp
+	ands 	[]*AndExp
 	ops 	[]string
 	tok 	*token.Token
 }
 
-func (e *Expression) Exps() []*Exp {
-	return e.exps
+func (e *Expression) AndExps() []*AndExp {
+	return e.ands
 }
 
 func (e *Expression) Operations() []string {
@@ -162,10 +234,90 @@ func (e *Expression) Token() *token.Token {
 	return e.tok
 }
 
+// Factors returns every factor of the expression, without entering the ones between parentheses.
+// A unary operation is replaced by the factor it operates on
+func (e *Expression) Factors() []*Factor {
+	facs := make([]*Factor, 0)
+	for _, a := range e.ands {
+		for _, eq := range a.eqs {
+			for _, r := range eq.rels {
+				for _, ex := range r.exps {
+					for _, t := range ex.terms {
+						for _, f := range t.facs {
+							for f.unary != nil {
+								f = f.unary.fac
+							}
+							facs = append(facs, f)
+						}
+					}
+				}
+			}
+		}
+	}
+	return facs
+}
+
+// AndExp is the logical and of EqualityExps
+type AndExp struct {
+	eqs 	[]*EqualityExp
+	ops 	[]string
+	tok 	*token.Token
+}
+
+func (a *AndExp) EqualityExps() []*EqualityExp {
+	return a.eqs
+}
+
+func (a *AndExp) Operations() []string {
+	return a.ops
+}
+
+func (a *AndExp) Token() *token.Token {
+	return a.tok
+}
+
+// EqualityExp compares RelationalExps with == or <>
+type EqualityExp struct {
+	rels 	[]*RelationalExp
+	ops 	[]string
+	tok 	*token.Token
+}
+
+func (e *EqualityExp) RelationalExps() []*RelationalExp {
+	return e.rels
+}
+
+func (e *EqualityExp) Operations() []string {
+	return e.ops
+}
+
+func (e *EqualityExp) Token() *token.Token {
+	return e.tok
+}
+
+// RelationalExp compares Exps with <, >, <= or >=
+type RelationalExp struct {
+	exps 	[]*Exp
+	ops 	[]string
+	tok 	*token.Token
+}
+
+func (r *RelationalExp) Exps() []*Exp {
+	return r.exps
+}
+
+func (r *RelationalExp) Operations() []string {
+	return r.ops
+}
+
+func (r *RelationalExp) Token() *token.Token {
+	return r.tok
+}
+
 type Attribute struct {
 	objId string
 	varId string
-	index *Expression
+	indexes []*Expression
 	tok *token.Token
 }
 
@@ -198,13 +350,14 @@ func (a *Attribute) Token() *token.Token{
 	return a.tok
 }
 
-func (a *Attribute) Index() *Expression {
-	return a.index
+// Indexes are the indexes of the assigned element, from the outermost dimension
+func (a *Attribute) Indexes() []*Expression {
+	return a.indexes
 }
 
 type ListElem struct{
 	id 		string
-	index 	*Expression
+	indexes	[]*Expression
 	tok 	*token.Token
 }
 
@@ -212,8 +365,9 @@ func (a *ListElem) Id() string{
 	return a.id
 }
 
-func (a *ListElem) Index() *Expression{
-	return a.index
+// Indexes are the indexes of the element, from the outermost dimension
+func (a *ListElem) Indexes() []*Expression{
+	return a.indexes
 }
 
 func (a ListElem) isConstantValue() bool {
@@ -239,12 +393,18 @@ func (a *ListElem) Token() *token.Token{
 type Vars struct {
 	variables 	[]*directories.VarEntry
 	tok 		*token.Token
+	init 		*Assign
 }
 
 func (a Vars) Variables() []*directories.VarEntry {
 	return a.variables
 }
 
+// Init is the assignment of the initial value of the variable, or nil if it is declared without one
+func (a Vars) Init() *Assign {
+	return a.init
+}
+
 func (a Vars) isVars() bool {
 	return true
 }
@@ -290,6 +450,7 @@ type Assign struct {
 	attr 	*Attribute
 	exp 	*Expression
 	tok 	*token.Token
+	op 		string
 }
 
 
@@ -301,6 +462,12 @@ func (a *Assign) Expression() *Expression {
 	return a.exp
 }
 
+// Operation is the operator of a compound assignment like +=, the target is operated with the expression
+// and the result is stored back. It is empty for a plain assignment
+func (a *Assign) Operation() string {
+	return a.op
+}
+
 func (a Assign) isVars() bool {
 	return false
 }
@@ -624,6 +791,176 @@ func (w *While) Token() *token.Token {
 	return w.tok
 }
 
+// Switch executes the statements of the case with a value equal to its expression, or the ones of
+// the default case if no value is equal
+type Switch struct {
+	exp 	*Expression
+	cases 	[]*Case
+	tok 	*token.Token
+}
+
+func (s *Switch) Expression() *Expression {
+	return s.exp
+}
+
+func (s *Switch) Cases() []*Case {
+	return s.cases
+}
+
+func (s *Switch) isVars() bool {
+	return false
+}
+
+func (s *Switch) isAssign() bool {
+	return false
+}
+
+func (s *Switch) isCondition() bool {
+	return false
+}
+
+func (s *Switch) isWrite() bool {
+	return false
+}
+
+func (s *Switch) isReturn() bool {
+	return false
+}
+
+func (s *Switch) isFor() bool {
+	return false
+}
+
+func (s *Switch) isWhile() bool {
+	return false
+}
+
+func (s *Switch) isFunctionCall() bool {
+	return false
+}
+
+func (s *Switch) isPredefinedFunction() bool {
+	return false
+}
+
+func (s *Switch) Token() *token.Token {
+	return s.tok
+}
+
+// Case is a clause of a switch, the default case has no values
+type Case struct {
+	values 		[]*Factor
+	stmts 		[]Statement
+	isDefault 	bool
+	tok 		*token.Token
+}
+
+func (c *Case) Values() []*Factor {
+	return c.values
+}
+
+func (c *Case) Statements() []Statement {
+	return c.stmts
+}
+
+func (c *Case) IsDefault() bool {
+	return c.isDefault
+}
+
+func (c *Case) Token() *token.Token {
+	return c.tok
+}
+
+// Break leaves the innermost loop or switch
+type Break struct {
+	tok 	*token.Token
+}
+
+func (b *Break) isVars() bool {
+	return false
+}
+
+func (b *Break) isAssign() bool {
+	return false
+}
+
+func (b *Break) isCondition() bool {
+	return false
+}
+
+func (b *Break) isWrite() bool {
+	return false
+}
+
+func (b *Break) isReturn() bool {
+	return false
+}
+
+func (b *Break) isFor() bool {
+	return false
+}
+
+func (b *Break) isWhile() bool {
+	return false
+}
+
+func (b *Break) isFunctionCall() bool {
+	return false
+}
+
+func (b *Break) isPredefinedFunction() bool {
+	return false
+}
+
+func (b *Break) Token() *token.Token {
+	return b.tok
+}
+
+// Continue jumps to the next iteration of the innermost loop
+type Continue struct {
+	tok 	*token.Token
+}
+
+func (c *Continue) isVars() bool {
+	return false
+}
+
+func (c *Continue) isAssign() bool {
+	return false
+}
+
+func (c *Continue) isCondition() bool {
+	return false
+}
+
+func (c *Continue) isWrite() bool {
+	return false
+}
+
+func (c *Continue) isReturn() bool {
+	return false
+}
+
+func (c *Continue) isFor() bool {
+	return false
+}
+
+func (c *Continue) isWhile() bool {
+	return false
+}
+
+func (c *Continue) isFunctionCall() bool {
+	return false
+}
+
+func (c *Continue) isPredefinedFunction() bool {
+	return false
+}
+
+func (c *Continue) Token() *token.Token {
+	return c.tok
+}
+
 type FunctionCall struct {
 	id 		string
 	params 	[]*Expression
@@ -689,7 +1026,7 @@ func (fc FunctionCall) isListElem() bool {
 func (fc FunctionCall) Token() *token.Token {
 	return fc.tok
 }
-/*
+// ClassFunctionCall is the call of a method of a class, obj is the variable the method is called on
 type ClassFunctionCall struct {
 	id 		string
 	obj 	string
@@ -709,10 +1046,147 @@ func (fc *ClassFunctionCall) Params() []*Expression {
 	return fc.params
 }
 
+func (fc *ClassFunctionCall) isVars() bool {
+	return false
+}
+
+func (fc *ClassFunctionCall) isAssign() bool {
+	return false
+}
+
+func (fc *ClassFunctionCall) isCondition() bool {
+	return false
+}
+
+func (fc *ClassFunctionCall) isWrite() bool {
+	return false
+}
+
+func (fc *ClassFunctionCall) isReturn() bool {
+	return false
+}
+
+func (fc *ClassFunctionCall) isFor() bool {
+	return false
+}
+
+func (fc *ClassFunctionCall) isWhile() bool {
+	return false
+}
+
+func (fc *ClassFunctionCall) isFunctionCall() bool {
+	return true
+}
+
+func (fc *ClassFunctionCall) isPredefinedFunction() bool {
+	return false
+}
+
+func (fc *ClassFunctionCall) isConstantValue() bool {
+	return false
+}
+
+func (fc *ClassFunctionCall) isAttribute() bool {
+	return false
+}
+
+func (fc *ClassFunctionCall) isListElem() bool {
+	return false
+}
+
 func (fc *ClassFunctionCall) Token() *token.Token {
 	return fc.tok
 }
-*/
+
+// Cast converts the value of an expression to a basic type, like int(x)
+type Cast struct {
+	typ 	*types.Type
+	exp 	*Expression
+	tok 	*token.Token
+}
+
+func (c *Cast) Type() *types.Type {
+	return c.typ
+}
+
+func (c *Cast) Expression() *Expression {
+	return c.exp
+}
+
+func (c *Cast) isConstantValue() bool {
+	return false
+}
+
+func (c *Cast) isAttribute() bool {
+	return false
+}
+
+func (c *Cast) isFunctionCall() bool {
+	return false
+}
+
+func (c *Cast) isListElem() bool {
+	return false
+}
+
+func (c *Cast) Token() *token.Token {
+	return c.tok
+}
+
+// ObjectLiteral creates an object with the attributes it sets, like Square{x: 10.0, y: 20.0}
+type ObjectLiteral struct {
+	typ 	*types.Type
+	fields 	[]*FieldInit
+	tok 	*token.Token
+}
+
+func (o *ObjectLiteral) Type() *types.Type {
+	return o.typ
+}
+
+func (o *ObjectLiteral) Fields() []*FieldInit {
+	return o.fields
+}
+
+func (o *ObjectLiteral) isConstantValue() bool {
+	return false
+}
+
+func (o *ObjectLiteral) isAttribute() bool {
+	return false
+}
+
+func (o *ObjectLiteral) isFunctionCall() bool {
+	return false
+}
+
+func (o *ObjectLiteral) isListElem() bool {
+	return false
+}
+
+func (o *ObjectLiteral) Token() *token.Token {
+	return o.tok
+}
+
+// FieldInit is the value given to an attribute in an object literal
+type FieldInit struct {
+	id 		string
+	exp 	*Expression
+	tok 	*token.Token
+}
+
+func (f *FieldInit) Id() string {
+	return f.id
+}
+
+func (f *FieldInit) Expression() *Expression {
+	return f.exp
+}
+
+func (f *FieldInit) Token() *token.Token {
+	return f.tok
+}
+
 // ConstantValue defines a type with a single basic value
 type Constant interface {
 	isConstantValue() 			bool
@@ -2,6 +2,9 @@ package ast
 
 import (
 	"strconv"
+	"strings"
+	"unicode/utf8"
+	"github.com/sdkvictor/golang-compiler/diagnostics"
 	"github.com/sdkvictor/golang-compiler/directories"
 	"github.com/sdkvictor/golang-compiler/gocc/token"
 	"github.com/sdkvictor/golang-compiler/types"
@@ -9,7 +12,7 @@ import (
 )
 
 // NewProgram creates a new program node which acts as the root of the tree
-func NewProgram(id, vars, functions interface{}) (*Program, error) { //OK
+func NewProgram(id, structs, vars, functions interface{}) (*Program, error) { //OK
 	fs, ok := functions.([]*Function)
 	if !ok {	
 		return nil, errutil.Newf("Invalid type for functions. Expected []*Function")
@@ -22,12 +25,143 @@ func NewProgram(id, vars, functions interface{}) (*Program, error) { //OK
 
 	ids := string(idtok.Lit)
 
-	v, ok := vars.([]*directories.VarEntry)
+	decls, ok := vars.([]*Vars)
 	if !ok {
-		return nil, errutil.Newf("Invalid type for variable declaration. Expected []*directories.VarEntry")
+		return nil, errutil.Newf("Invalid type for variable declaration. Expected []*Vars")
 	}
 
-	return &Program{fs, ids, v}, nil
+	v := make([]*directories.VarEntry, 0)
+	for _, d := range decls {
+		v = append(v, d.variables...)
+	}
+
+	st, ok := structs.([]*StructDec)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for struct declarations. Expected []*StructDec")
+	}
+
+	// The methods of the classes are compiled as functions
+	for _, s := range st {
+		fs = append(fs, s.methods...)
+	}
+
+	return &Program{fs, ids, v, decls, st}, nil
+}
+
+// NewStructDec creates a struct declaration node with the fields in the order they were declared
+func NewStructDec(id, fields interface{}) (*StructDec, error) {
+	i, ok := id.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for struct id. Expected token")
+	}
+
+	f, ok := fields.([]*directories.VarEntry)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for struct fields. Expected []*directories.VarEntry")
+	}
+
+	return &StructDec{string(i.Lit), f, make([]*Function, 0), nil, false, i}, nil
+}
+
+// NewClassMembers creates the empty body of a class, the members are added by AppendClassMember
+func NewClassMembers() (*StructDec, error) {
+	return &StructDec{"", make([]*directories.VarEntry, 0), make([]*Function, 0), nil, true, nil}, nil
+}
+
+// AppendClassMember adds a field declaration or a method to the start of the body of a class
+func AppendClassMember(member, body interface{}) (*StructDec, error) {
+	b, ok := body.(*StructDec)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for class body. Expected *StructDec")
+	}
+
+	if f, ok := member.(*Function); ok {
+		b.methods = append([]*Function{f}, b.methods...)
+		return b, nil
+	}
+
+	v, ok := member.([]*directories.VarEntry)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for class member. Expected *Function or []*directories.VarEntry")
+	}
+
+	b.fields = append(v, b.fields...)
+	return b, nil
+}
+
+// NewClassDec completes the body of a class. Every method becomes a function named Class.method
+// whose first parameter is the object it is called on, named this
+func NewClassDec(id, embed, body interface{}) (*StructDec, error) {
+	i, ok := id.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for class id. Expected token")
+	}
+
+	b, ok := body.(*StructDec)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for class body. Expected *StructDec")
+	}
+
+	b.id = string(i.Lit)
+	b.tok = i
+
+	if embed != nil {
+		t, ok := embed.(*types.Type)
+		if !ok {
+			return nil, errutil.Newf("Invalid type for embedded object. Expected *types.Type")
+		}
+		b.embed = t
+	}
+
+	for _, m := range b.methods {
+		this := &token.Token{Type: m.tok.Type, Lit: []byte("this"), Pos: m.tok.Pos}
+		params, err := AppendParamsList(types.NewStructType(types.NewStruct(b.id), 0, 0), this, m.params)
+		if err != nil {
+			return nil, err
+		}
+
+		m.id = b.id + "." + m.id
+		m.params = params
+		m.CreateKey()
+	}
+
+	return b, nil
+}
+
+// NewMethod creates the function of a method, its type is the void token or a *types.Type
+func NewMethod(typ, id, params, statements interface{}) (*Function, error) {
+	if tok, ok := typ.(*token.Token); ok {
+		t, err := NewType(tok)
+		if err != nil {
+			return nil, err
+		}
+		typ = t
+	}
+
+	return NewFunction(typ, id, params, statements)
+}
+
+func NewStructList(s interface{}) ([]*StructDec, error) {
+	st, ok := s.(*StructDec)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for struct. Expected *StructDec")
+	}
+
+	return []*StructDec{st}, nil
+}
+
+func AppendStructList(s, list interface{}) ([]*StructDec, error) {
+	st, ok := s.(*StructDec)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for struct. Expected *StructDec")
+	}
+
+	l, ok := list.([]*StructDec)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for struct list. Expected []*StructDec")
+	}
+
+	return append([]*StructDec{st}, l...), nil
 }
 
 // NewFunctionList creates a new function list of all the program's functions
@@ -232,34 +366,149 @@ func NewType(t interface{}) (*types.Type, error) { //OK
 }
 
 
-// AppendType
-func NewTypeArray(typ, size interface{}) (*types.Type, error) { // OK?
+// NewTypeArray creates the type of an array with one dimension for every size, int[3][4] is an array of 3 arrays of 4 ints
+func NewTypeArray(typ, sizes interface{}) (*types.Type, error) { // OK?
 	t, ok := typ.(*types.Type)
 	if !ok {
 		return nil, errutil.Newf("Invalid type for typ. Expected *types.Type")
 	}
 	
+	dims, ok := sizes.([]*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for sizes. Expected []*token.Token")
+	}
+
+	nt := t.Copy()
+	for _, s := range dims {
+		sint, err := strconv.Atoi(string(s.Lit))
+		if err != nil {
+			return nil, diagnostics.Errorf(diagnostics.ErrSyntax, s, "Cannot parse %s to int", string(s.Lit))
+		}
+
+		if sint < 1 {
+			return nil, diagnostics.Errorf(diagnostics.ErrSyntax, s, "Cannot declare array of size less than 1")
+		}
+
+		nt.AddDimension(sint)
+	}
+
+	return nt, nil
+}
+
+// NewDimensionList
+func NewDimensionList(size interface{}) ([]*token.Token, error) {
 	s, ok := size.(*token.Token)
 	if !ok {
 		return nil, errutil.Newf("Invalid type for size. Expected token")
 	}
 
-	sint, err := strconv.Atoi(string(s.Lit))
+	return []*token.Token{s}, nil
+}
+
+// AppendDimensionList
+func AppendDimensionList(size, list interface{}) ([]*token.Token, error) {
+	s, ok := size.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for size. Expected token")
+	}
+
+	dims, ok := list.([]*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for sizes. Expected []*token.Token")
+	}
+
+	return append([]*token.Token{s}, dims...), nil
+}
+
+// NewStructType creates the type of a user declared struct, its fields are resolved later by the semantic check
+func NewStructType(id interface{}) (*types.Type, error) {
+	i, ok := id.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for struct type. Expected token")
+	}
+
+	return types.NewStructType(types.NewStruct(string(i.Lit)), 0, 0), nil
+}
+
+// NewStructTypeArray creates the type of an array of structs, every size must be an int constant
+func NewStructTypeArray(id, sizes interface{}) (*types.Type, error) {
+	t, err := NewStructType(id)
 	if err != nil {
-		return nil, errutil.Newf("Cannot parse %s to int", string(s.Lit)) 
+		return nil, err
 	}
 
-	if sint < 1 {
-		return nil, errutil.Newf("%+v: Cannot declare array of size less than 1", s)
+	exps, ok := sizes.([]*Expression)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for sizes. Expected []*Expression")
 	}
 
-	if t.IsObject() {
-		return types.NewObjectType(t.Object(), 1, sint), nil
-	} else {
-		return types.NewDataType(t.Basic(), 1, sint), nil
+	for _, e := range exps {
+		c, ok := e.constantValue()
+		if !ok || c.Type().Basic() != types.Int || c.Type().List() != 0 {
+			return nil, diagnostics.Errorf(diagnostics.ErrSyntax, e.Token(), "Array size must be an int constant")
+		}
+
+		sint, err := strconv.Atoi(c.Value())
+		if err != nil {
+			return nil, diagnostics.Errorf(diagnostics.ErrSyntax, c.Token(), "Cannot parse %s to int", c.Value())
+		}
+
+		if sint < 1 {
+			return nil, diagnostics.Errorf(diagnostics.ErrSyntax, c.Token(), "Cannot declare array of size less than 1")
+		}
+
+		t.AddDimension(sint)
+	}
+
+	return t, nil
+}
+
+// NewListType creates the type of a list<T>, the elements can be of a basic type, an object or a struct
+func NewListType(open, elem, close interface{}) (*types.Type, error) {
+	o, ok := open.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for list. Expected token")
+	}
+	if string(o.Lit) != "<" {
+		return nil, diagnostics.Errorf(diagnostics.ErrSyntax, o, "Invalid type for list. Expected list<T>")
+	}
+
+	c, ok := close.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for list. Expected token")
+	}
+	if string(c.Lit) != ">" {
+		return nil, diagnostics.Errorf(diagnostics.ErrSyntax, c, "Invalid type for list. Expected list<T>")
+	}
+
+	if id, ok := elem.(*token.Token); ok {
+		t, err := NewStructType(id)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewListType(t), nil
+	}
+
+	t, ok := elem.(*types.Type)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for list elements. Expected *types.Type")
 	}
+
+	return types.NewListType(t), nil
 }
 
+// constantValue returns the constant of an expression made of a single constant factor
+func (e *Expression) constantValue() (*ConstantValue, bool) {
+	if len(e.ands) != 1 || len(e.ands[0].eqs) != 1 || len(e.ands[0].eqs[0].rels) != 1 {
+		return nil, false
+	}
+	r := e.ands[0].eqs[0].rels[0]
+	if len(r.exps) != 1 || len(r.exps[0].terms) != 1 || len(r.exps[0].terms[0].facs) != 1 {
+		return nil, false
+	}
+	c, ok := r.exps[0].terms[0].facs[0].cv.(*ConstantValue)
+	return c, ok
+}
 
 // NewFunctionCall creates a new FunctionCall node which acts as the children of the program or function, which is the function call
 func NewFunctionCall(id, exps interface{}) (*FunctionCall, error) {
@@ -290,6 +539,26 @@ func NewFunctionCallId(id interface{}) (*FunctionCall, error) {
 	return &FunctionCall{d, make([]*Expression, 0), i}, nil
 } 
 
+// NewClassFunctionCall creates the call of the method id on the variable obj
+func NewClassFunctionCall(obj, id, exps interface{}) (*ClassFunctionCall, error) {
+	o, ok := obj.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for method call object. expected Token")
+	}
+
+	i, ok := id.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for method call id. expected Token")
+	}
+
+	e, ok := exps.([]*Expression)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for args. Expected []*Expression, got %v", exps)
+	}
+
+	return &ClassFunctionCall{string(i.Lit), string(o.Lit), e, o}, nil
+}
+
 func NewArgumentExpression(exp interface{}) ([]*Expression, error) {
 	s, ok := exp.(*Expression) 
 	if !ok {
@@ -315,6 +584,83 @@ func AppendArgumentExpression(exp, list interface{}) ([]*Expression, error) {
 	return slist, nil
 }
 
+// NewCast creates the conversion of an expression to the type of the keyword
+func NewCast(typ, exp interface{}) (*Cast, error) {
+	tok, ok := typ.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for cast type. Expected token")
+	}
+
+	t, err := NewType(tok)
+	if err != nil {
+		return nil, err
+	}
+
+	e, ok := exp.(*Expression)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for cast expression. Expected *Expression")
+	}
+
+	return &Cast{t, e, tok}, nil
+}
+
+// NewObjectLiteral creates an object of the type with the attributes it sets, tok is its opening bracket
+func NewObjectLiteral(typ, tok, fields interface{}) (*ObjectLiteral, error) {
+	t, ok := typ.(*types.Type)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for object literal type. Expected *types.Type")
+	}
+
+	o, ok := tok.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for object literal. Expected token")
+	}
+
+	f, ok := fields.([]*FieldInit)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for object literal attributes. Expected []*FieldInit")
+	}
+
+	return &ObjectLiteral{t, f, o}, nil
+}
+
+func NewFieldInit(id, exp interface{}) (*FieldInit, error) {
+	i, ok := id.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for attribute id. Expected token")
+	}
+
+	e, ok := exp.(*Expression)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for attribute value. Expected *Expression")
+	}
+
+	return &FieldInit{string(i.Lit), e, i}, nil
+}
+
+func NewFieldInitList(f interface{}) ([]*FieldInit, error) {
+	fi, ok := f.(*FieldInit)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for attribute value. Expected *FieldInit")
+	}
+
+	return []*FieldInit{fi}, nil
+}
+
+func AppendFieldInitList(f, list interface{}) ([]*FieldInit, error) {
+	fi, ok := f.(*FieldInit)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for attribute value. Expected *FieldInit")
+	}
+
+	l, ok := list.([]*FieldInit)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for attribute values. Expected []*FieldInit")
+	}
+
+	return append([]*FieldInit{fi}, l...), nil
+}
+
 // NewConstantBool
 func NewConstantBool(value interface{}) (*ConstantValue, error) {
 	val, ok := value.(*token.Token)
@@ -374,7 +720,8 @@ func NewConstantChar(value interface{}) (*ConstantValue, error) {
 	return &ConstantValue{types.NewDataType(types.Char, 0, 0), v, val}, nil
 }
 
-// NewConstantString
+// NewConstantString creates a string constant with the text of the literal, without its quotes and
+// with its escape sequences decoded
 func NewConstantString(str interface{}) (*ConstantValue, error) {
 
     val, ok := str.(*token.Token)
@@ -382,11 +729,53 @@ func NewConstantString(str interface{}) (*ConstantValue, error) {
         return nil, errutil.Newf("Invalid type for string. Expected token")
     }
 
-	valstr := string(val.Lit)
-	
+	valstr, err := decodeString(val)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ConstantValue{types.NewDataType(types.String, 0, 0), valstr, val}, nil
 }
 
+//decodeString removes the quotes of a string literal and replaces its escape sequences,
+// the lexer already checked that every escape is one of \n \t \" \\ and \u{...}. An invalid
+// code point is reported at the position of the literal
+func decodeString(tok *token.Token) (string, error) {
+	lit := string(tok.Lit)
+	r := []rune(lit[1 : len(lit)-1])
+	var builder strings.Builder
+
+	for i := 0; i < len(r); i++ {
+		if r[i] != '\\' {
+			builder.WriteRune(r[i])
+			continue
+		}
+
+		i++
+		switch r[i] {
+		case 'n':
+			builder.WriteRune('\n')
+		case 't':
+			builder.WriteRune('\t')
+		case 'u':
+			end := i + 2
+			for r[end] != '}' {
+				end++
+			}
+			code, err := strconv.ParseUint(string(r[i+2:end]), 16, 32)
+			if err != nil || !utf8.ValidRune(rune(code)) {
+				return "", diagnostics.Errorf(diagnostics.ErrSyntax, tok, "Invalid unicode code point %s in string", string(r[i+2:end]))
+			}
+			builder.WriteRune(rune(code))
+			i = end
+		default:
+			builder.WriteRune(r[i])
+		}
+	}
+
+	return builder.String(), nil
+}
+
 // NewReturn
 func NewReturn(returnToken, exp interface{} ) (*Return, error) { //OK
 	retTok, ok := returnToken.(*token.Token)
@@ -421,6 +810,26 @@ func NewWhile(tok, exp, block interface{}) (*While, error) {
 	return &While{e, b, t}, nil
 }
 
+// NewBreak
+func NewBreak(tok interface{}) (*Break, error) {
+	t, ok := tok.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for break keyword. Expected token")
+	}
+
+	return &Break{t}, nil
+}
+
+// NewContinue
+func NewContinue(tok interface{}) (*Continue, error) {
+	t, ok := tok.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for continue keyword. Expected token")
+	}
+
+	return &Continue{t}, nil
+}
+
 // NewWrite
 func NewWrite(tok, exp interface{}) (*Write, error) {
 	t, ok := tok.(*token.Token)
@@ -468,14 +877,69 @@ func NewFor(tok, init, cond, op, block interface{}) (*For, error) {
 	return &For{i, c, o, b, t}, nil
 }
 
-func NewVarsDec(vars interface{}) (*Vars, error) {
-	t, ok := vars.([]*directories.VarEntry)
+func NewVarsDec(typ, ids interface{}) (*Vars, error) {
+	t, err := NewVarsList(typ, ids)
+	if err != nil {
+		return nil, err
+	}
+
+
+	return &Vars{t, t[0].Token(), nil}, nil
+}
+
+// NewVarsDecInit creates the declaration of a variable with the assignment of its initial value
+func NewVarsDecInit(typ, id, exp interface{}) (*Vars, error) {
+	i, ok := id.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for id. Expected token")
+	}
+
+	t, err := NewVarsList(typ, []*token.Token{i})
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := NewAssignWithoutAttr(i, exp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Vars{t, i, a}, nil
+}
+
+// NewConstantDec creates the declaration of a constant, its value is folded by the semantic analysis
+func NewConstantDec(typ, id, exp interface{}) (*Vars, error) {
+	v, err := NewVarsDecInit(typ, id, exp)
+	if err != nil {
+		return nil, err
+	}
+
+	v.variables[0].SetConstant()
+
+	return v, nil
+}
+
+func NewVarsDecList(v interface{}) ([]*Vars, error) {
+	vars, ok := v.(*Vars)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for variable declaration. Expected *Vars")
+	}
+
+	return []*Vars{vars}, nil
+}
+
+func AppendVarsDecList(v, list interface{}) ([]*Vars, error) {
+	vars, ok := v.(*Vars)
 	if !ok {
-		return nil, errutil.Newf("Invalid type for vars keyword. Expected *[]directories.VarEntry")
+		return nil, errutil.Newf("Invalid type for variable declaration. Expected *Vars")
 	}
 
+	l, ok := list.([]*Vars)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for variable declarations. Expected []*Vars")
+	}
 
-	return &Vars{t, t[0].Token()}, nil
+	return append([]*Vars{vars}, l...), nil
 }
 
 // NewAssignWithoutAttr
@@ -494,7 +958,7 @@ func NewAssignWithoutAttr(id, exp interface{}) (*Assign, error) {
 
 	a := &Attribute{idstr, "", nil, i}
 
-	return &Assign{a, e, i}, nil
+	return &Assign{a, e, i, ""}, nil
 }
 
 // NewAssignWithAttr
@@ -509,7 +973,7 @@ func NewAssignWithAttr(attr, exp interface{}) (*Assign, error) {
 		return nil, errutil.Newf("Invalid type for assign expression. Expected Expression")
 	}
 
-	return &Assign{a, e, a.tok}, nil
+	return &Assign{a, e, a.tok, ""}, nil
 }
 
 func NewAssignWithIndex(le, exp interface{}) (*Assign, error) {
@@ -523,9 +987,62 @@ func NewAssignWithIndex(le, exp interface{}) (*Assign, error) {
 		return nil, errutil.Newf("Invalid type for assign expression. Expected Expression")
 	}
 
-	attr := &Attribute{listelem.Id(), "", listelem.Index(), listelem.Token()}
+	attr := &Attribute{listelem.Id(), "", listelem.Indexes(), listelem.Token()}
+
+	return &Assign{attr, e, attr.Token(), ""}, nil
+}
+
+// NewCompoundAssign creates an assignment like x += 2 which operates the target with the expression,
+// the target is an id, an attribute or an element
+func NewCompoundAssign(target, op, exp interface{}) (*Assign, error) {
+	o, ok := op.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for assign operation. Expected token")
+	}
+
+	var a *Assign
+	var err error
+	switch t := target.(type) {
+	case *token.Token:
+		a, err = NewAssignWithoutAttr(t, exp)
+	case *Attribute:
+		a, err = NewAssignWithAttr(t, exp)
+	case *ListElem:
+		a, err = NewAssignWithIndex(t, exp)
+	default:
+		return nil, errutil.Newf("Invalid type for assign target. Expected id, Attribute or ListElem, got %T", target)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The operator is the first character of += or ++
+	a.op = string(o.Lit[:1])
+
+	return a, nil
+}
+
+// NewIncrement creates x++ or x-- as the compound assignment of the literal 1, located at the operator
+func NewIncrement(target, op interface{}) (*Assign, error) {
+	o, ok := op.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for increment operation. Expected token")
+	}
+
+	one, err := NewConstantInt(&token.Token{Type: o.Type, Lit: []byte("1"), Pos: o.Pos})
+	if err != nil {
+		return nil, err
+	}
+
+	f := &Factor{nil, one, nil, one.tok}
+	t := &Term{[]*Factor{f}, make([]string, 0), f.tok}
+	e := &Exp{[]*Term{t}, make([]string, 0), t.tok}
+	r := &RelationalExp{[]*Exp{e}, make([]string, 0), e.tok}
+	eq := &EqualityExp{[]*RelationalExp{r}, make([]string, 0), r.tok}
+	and := &AndExp{[]*EqualityExp{eq}, make([]string, 0), eq.tok}
+	exp := &Expression{[]*AndExp{and}, make([]string, 0), and.tok}
 
-	return &Assign{attr, e, attr.Token()}, nil
+	return NewCompoundAssign(target, o, exp)
 }
 
 func NewAttribute(id1, id2 interface{}) (*Attribute, error) {
@@ -542,6 +1059,21 @@ func NewAttribute(id1, id2 interface{}) (*Attribute, error) {
 	return &Attribute{string(id1t.Lit), string(id2t.Lit), nil, id1t}, nil
 }
 
+// NewElemAttribute creates the field or attribute of an element of an array or a list, like team[i].score
+func NewElemAttribute(le, id interface{}) (*Attribute, error) {
+	listelem, ok := le.(*ListElem)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for list element. Expected ListElem")
+	}
+
+	i, ok := id.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for obj attribute. Expected token, got %T", id)
+	}
+
+	return &Attribute{listelem.Id(), string(i.Lit), listelem.Indexes(), listelem.Token()}, nil
+}
+
 // NewCondition
 func NewCondition(id, exp, stmts, elseStmts interface{}) (*Condition, error) {
 	i, ok := id.(*token.Token)
@@ -567,6 +1099,121 @@ func NewCondition(id, exp, stmts, elseStmts interface{}) (*Condition, error) {
 	return &Condition{e, s, els, i}, nil
 }
 
+// NewConditionElseIf creates a condition whose else block is another condition
+func NewConditionElseIf(id, exp, stmts, elseif interface{}) (*Condition, error) {
+	c, ok := elseif.(*Condition)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for else if. Expected *Condition, got %T", elseif)
+	}
+
+	return NewCondition(id, exp, stmts, []Statement{c})
+}
+
+// NewSwitch
+func NewSwitch(tok, exp, cases interface{}) (*Switch, error) {
+	t, ok := tok.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for switch keyword. Expected token")
+	}
+
+	e, ok := exp.(*Expression)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for switch expression. Expected *Expression, got %T", exp)
+	}
+
+	c, ok := cases.([]*Case)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for cases. Expected []*Case, got %T", cases)
+	}
+
+	return &Switch{e, c, t}, nil
+}
+
+// NewCaseList
+func NewCaseList(c interface{}) ([]*Case, error) {
+	cs, ok := c.(*Case)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for case. Expected *Case, got %T", c)
+	}
+
+	return []*Case{cs}, nil
+}
+
+// AppendCaseList
+func AppendCaseList(c, list interface{}) ([]*Case, error) {
+	cs, ok := c.(*Case)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for case. Expected *Case, got %T", c)
+	}
+
+	l, ok := list.([]*Case)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for cases. Expected []*Case, got %T", list)
+	}
+
+	return append([]*Case{cs}, l...), nil
+}
+
+// NewCase
+func NewCase(tok, values, stmts interface{}) (*Case, error) {
+	t, ok := tok.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for case keyword. Expected token")
+	}
+
+	v, ok := values.([]*Factor)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for case values. Expected []*Factor, got %T", values)
+	}
+
+	s, ok := stmts.([]Statement)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for case statements. Expected []Statement, got %T", stmts)
+	}
+
+	return &Case{v, s, false, t}, nil
+}
+
+// NewDefaultCase
+func NewDefaultCase(tok, stmts interface{}) (*Case, error) {
+	t, ok := tok.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for default keyword. Expected token")
+	}
+
+	s, ok := stmts.([]Statement)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for default statements. Expected []Statement, got %T", stmts)
+	}
+
+	return &Case{make([]*Factor, 0), s, true, t}, nil
+}
+
+// NewCaseValues
+func NewCaseValues(value interface{}) ([]*Factor, error) {
+	f, ok := value.(*Factor)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for case value. Expected *Factor, got %T", value)
+	}
+
+	return []*Factor{f}, nil
+}
+
+// AppendCaseValues
+func AppendCaseValues(value, list interface{}) ([]*Factor, error) {
+	f, ok := value.(*Factor)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for case value. Expected *Factor, got %T", value)
+	}
+
+	l, ok := list.([]*Factor)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for case values. Expected []*Factor, got %T", list)
+	}
+
+	return append([]*Factor{f}, l...), nil
+}
+
 // NewCondition
 func NewConditionNoElseStmts(id, exp, stmts interface{}) (*Condition, error) {
 	i, ok := id.(*token.Token)
@@ -587,109 +1234,209 @@ func NewConditionNoElseStmts(id, exp, stmts interface{}) (*Condition, error) {
 	return &Condition{e, s, make([]Statement, 0), i}, nil
 }
 
-// New Expression
-func NewExpression(exp interface{}) (*Expression, error) {
-	e, ok := exp.(*Exp)
+// NewExpression
+func NewExpression(andexp interface{}) (*Expression, error) {
+	c, ok := andexp.(*AndExp)
 	if !ok {
-		return nil, errutil.Newf("Invalid type for exp. Expected *Exp")
+		return nil, errutil.Newf("Invalid type for andexp. Expected *AndExp, got %T", andexp)
 	}
 
-	return &Expression{[]*Exp{e}, make([]string, 0), e.tok}, nil
+	return &Expression{[]*AndExp{c}, make([]string, 0), c.tok}, nil
 }
 
-// AppendExpression
-func AppendExpression(exp, op, expre interface{}) (*Expression, error) {
-	e, ok := exp.(*Exp)
+// AppendExpression adds the operand at the right, every level of precedence appends the same way so
+// its operations are evaluated from left to right
+func AppendExpression(expression, op, andexp interface{}) (*Expression, error) {
+	e, ok := expression.(*Expression)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for expression. Expected *Expression, got %T", expression)
+	}
+
+	o, ok := op.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for op. Expected *token.Token, got %T", op)
+	}
+
+	c, ok := andexp.(*AndExp)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for andexp. Expected *AndExp, got %T", andexp)
+	}
+
+	e.ands = append(e.ands, c)
+	e.ops = append(e.ops, string(o.Lit))
+
+	return e, nil
+}
+
+// NewAndExp
+func NewAndExp(equalityexp interface{}) (*AndExp, error) {
+	c, ok := equalityexp.(*EqualityExp)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for equalityexp. Expected *EqualityExp, got %T", equalityexp)
+	}
+
+	return &AndExp{[]*EqualityExp{c}, make([]string, 0), c.tok}, nil
+}
+
+// AppendAndExp
+func AppendAndExp(andexp, op, equalityexp interface{}) (*AndExp, error) {
+	e, ok := andexp.(*AndExp)
 	if !ok {
-		return nil, errutil.Newf("Invalid type for exps. Expected []*Exp")
+		return nil, errutil.Newf("Invalid type for andexp. Expected *AndExp, got %T", andexp)
 	}
 
 	o, ok := op.(*token.Token)
 	if !ok {
-		return nil, errutil.Newf("Invalid type for ops. Expected []string")
+		return nil, errutil.Newf("Invalid type for op. Expected *token.Token, got %T", op)
 	}
 
+	c, ok := equalityexp.(*EqualityExp)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for equalityexp. Expected *EqualityExp, got %T", equalityexp)
+	}
+
+	e.eqs = append(e.eqs, c)
+	e.ops = append(e.ops, string(o.Lit))
+
+	return e, nil
+}
+
+// NewEqualityExp
+func NewEqualityExp(relationalexp interface{}) (*EqualityExp, error) {
+	c, ok := relationalexp.(*RelationalExp)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for relationalexp. Expected *RelationalExp, got %T", relationalexp)
+	}
+
+	return &EqualityExp{[]*RelationalExp{c}, make([]string, 0), c.tok}, nil
+}
+
+// AppendEqualityExp
+func AppendEqualityExp(equalityexp, op, relationalexp interface{}) (*EqualityExp, error) {
+	e, ok := equalityexp.(*EqualityExp)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for equalityexp. Expected *EqualityExp, got %T", equalityexp)
+	}
+
+	o, ok := op.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for op. Expected *token.Token, got %T", op)
+	}
+
+	c, ok := relationalexp.(*RelationalExp)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for relationalexp. Expected *RelationalExp, got %T", relationalexp)
+	}
+
+	// <> is the same operation as !=
 	ostr := string(o.Lit)
+	if ostr == "<>" {
+		ostr = "!="
+	}
 
-	expression, ok := expre.(*Expression)
+	e.rels = append(e.rels, c)
+	e.ops = append(e.ops, ostr)
+
+	return e, nil
+}
+
+// NewRelationalExp
+func NewRelationalExp(exp interface{}) (*RelationalExp, error) {
+	c, ok := exp.(*Exp)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for exp. Expected *Exp, got %T", exp)
+	}
+
+	return &RelationalExp{[]*Exp{c}, make([]string, 0), c.tok}, nil
+}
+
+// AppendRelationalExp
+func AppendRelationalExp(relationalexp, op, exp interface{}) (*RelationalExp, error) {
+	e, ok := relationalexp.(*RelationalExp)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for relationalexp. Expected *RelationalExp, got %T", relationalexp)
+	}
+
+	o, ok := op.(*token.Token)
 	if !ok {
-		return nil, errutil.Newf("Invalid type for expression. Expected *Expression")
+		return nil, errutil.Newf("Invalid type for op. Expected *token.Token, got %T", op)
 	}
 
-	expression.exps = append([]*Exp{e}, expression.exps...)
-	expression.ops = append([]string{ostr}, expression.ops...)
+	c, ok := exp.(*Exp)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for exp. Expected *Exp, got %T", exp)
+	}
+
+	e.exps = append(e.exps, c)
+	e.ops = append(e.ops, string(o.Lit))
 
-	return expression, nil
+	return e, nil
 }
 
 // NewExp
 func NewExp(term interface{}) (*Exp, error) {
-	t, ok := term.(*Term)
+	c, ok := term.(*Term)
 	if !ok {
-		return nil, errutil.Newf("Invalid type for terms. Expected *Term")
+		return nil, errutil.Newf("Invalid type for term. Expected *Term, got %T", term)
 	}
 
-	return &Exp{[]*Term{t}, make([]string, 0), t.tok}, nil
+	return &Exp{[]*Term{c}, make([]string, 0), c.tok}, nil
 }
 
 // AppendExp
-func AppendExp(term, op, exp interface{}) (*Exp, error) {
-	t, ok := term.(*Term)
+func AppendExp(exp, op, term interface{}) (*Exp, error) {
+	e, ok := exp.(*Exp)
 	if !ok {
-		return nil, errutil.Newf("Invalid type for exps. Expected Term")
+		return nil, errutil.Newf("Invalid type for exp. Expected *Exp, got %T", exp)
 	}
 
 	o, ok := op.(*token.Token)
 	if !ok {
-		return nil, errutil.Newf("Invalid type for ops. Expected *token.Token")
+		return nil, errutil.Newf("Invalid type for op. Expected *token.Token, got %T", op)
 	}
 
-	ostr := string(o.Lit)
-
-	e, ok := exp.(*Exp)
+	c, ok := term.(*Term)
 	if !ok {
-		return nil, errutil.Newf("Invalid type for exp. Expected *Exp")
+		return nil, errutil.Newf("Invalid type for term. Expected *Term, got %T", term)
 	}
 
-	e.terms = append([]*Term{t}, e.terms...)
-	e.ops = append([]string{ostr}, e.ops...)
+	e.terms = append(e.terms, c)
+	e.ops = append(e.ops, string(o.Lit))
 
 	return e, nil
 }
 
-// NewExp
+// NewTerm
 func NewTerm(factor interface{}) (*Term, error) {
-	f, ok := factor.(*Factor)
+	c, ok := factor.(*Factor)
 	if !ok {
-		return nil, errutil.Newf("Invalid type for factors. Expected *Factor")
+		return nil, errutil.Newf("Invalid type for factor. Expected *Factor, got %T", factor)
 	}
 
-	return &Term{[]*Factor{f}, make([]string, 0), f.tok}, nil
+	return &Term{[]*Factor{c}, make([]string, 0), c.tok}, nil
 }
 
 // AppendTerm
-func AppendTerm(factor, op, term interface{}) (*Term, error) {
-	f, ok := factor.(*Factor)
+func AppendTerm(term, op, factor interface{}) (*Term, error) {
+	e, ok := term.(*Term)
 	if !ok {
-		return nil, errutil.Newf("Invalid type for exps. Expected Factor")
+		return nil, errutil.Newf("Invalid type for term. Expected *Term, got %T", term)
 	}
 
 	o, ok := op.(*token.Token)
 	if !ok {
-		return nil, errutil.Newf("Invalid type for ops. Expected *token.Token")
+		return nil, errutil.Newf("Invalid type for op. Expected *token.Token, got %T", op)
 	}
 
-	ostr := string(o.Lit)
-
-	t, ok := term.(*Term)
+	c, ok := factor.(*Factor)
 	if !ok {
-		return nil, errutil.Newf("Invalid type for exp. Expected *Exp")
+		return nil, errutil.Newf("Invalid type for factor. Expected *Factor, got %T", factor)
 	}
 
-	t.facs = append([]*Factor{f}, t.facs...)
-	t.ops = append([]string{ostr}, t.ops...)
+	e.facs = append(e.facs, c)
+	e.ops = append(e.ops, string(o.Lit))
 
-	return t, nil
+	return e, nil
 }
 
 // NewFactor
@@ -699,7 +1446,7 @@ func NewFactor(expre interface{}) (*Factor, error) {
 		return nil, errutil.Newf("Invalid type for expression. Expected *Expression got %T", expre)
 	}
 
-	return &Factor{expression, nil, expression.tok}, nil
+	return &Factor{expression, nil, nil, expression.tok}, nil
 }
 
 // NewVCFactor
@@ -709,8 +1456,34 @@ func NewVCFactor(vcte interface{}) (*Factor, error) {
 		return nil, errutil.Newf("Invalid type for ConstantValue. Expected Constant got %T", vcte)
 	}
 
-	return &Factor{nil, vc, vc.Token()}, nil
+	return &Factor{nil, vc, nil, vc.Token()}, nil
+
+}
+
+// NewUnaryFactor creates a Factor with a unary operation. The negation of a number literal is folded
+// into a negative constant, since the literals of the lexer do not have a sign
+func NewUnaryFactor(op, factor interface{}) (*Factor, error) {
+	o, ok := op.(*token.Token)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for op. Expected *token.Token, got %T", op)
+	}
+
+	f, ok := factor.(*Factor)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for factor. Expected *Factor, got %T", factor)
+	}
+
+	ostr := string(o.Lit)
 
+	if cv, ok := f.cv.(*ConstantValue); ok && ostr == "-" && !strings.HasPrefix(cv.value, "-") {
+		if cv.t.Basic() == types.Int || cv.t.Basic() == types.Float {
+			value := "-" + cv.value
+			tok := &token.Token{Type: cv.tok.Type, Lit: []byte(value), Pos: o.Pos}
+			return &Factor{nil, &ConstantValue{cv.t, value, tok}, nil, tok}, nil
+		}
+	}
+
+	return &Factor{nil, nil, &Unary{ostr, f, o}, o}, nil
 }
 
 func NewVarsList(typ, ids interface{}) ([]*directories.VarEntry, error) {
@@ -793,7 +1566,7 @@ func AppendIdList(id, list interface{}) ([]*token.Token, error) {
 	return append([]*token.Token{i}, idslist...), nil
 }
 
-func NewListElem(id, expression interface{}) (*ListElem, error) {
+func NewListElem(id, indexes interface{}) (*ListElem, error) {
 	i, ok := id.(*token.Token)
 	if !ok {
 		return nil, errutil.Newf("Invalid type for listelem id. Expected token")
@@ -801,10 +1574,35 @@ func NewListElem(id, expression interface{}) (*ListElem, error) {
 
 	idstr := string(i.Lit)
 
-	expr, ok := expression.(*Expression)
+	exprs, ok := indexes.([]*Expression)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for list elem indexes. Expected []*Expresion")
+	}
+
+	return &ListElem{idstr, exprs, i}, nil
+}
+
+// NewIndexList
+func NewIndexList(exp interface{}) ([]*Expression, error) {
+	e, ok := exp.(*Expression)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for index. Expected *Expression")
+	}
+
+	return []*Expression{e}, nil
+}
+
+// AppendIndexList
+func AppendIndexList(exp, list interface{}) ([]*Expression, error) {
+	e, ok := exp.(*Expression)
+	if !ok {
+		return nil, errutil.Newf("Invalid type for index. Expected *Expression")
+	}
+
+	exps, ok := list.([]*Expression)
 	if !ok {
-		return nil, errutil.Newf("Invalid type for list elem expression. Expected *Expresion")
+		return nil, errutil.Newf("Invalid type for indexes. Expected []*Expression")
 	}
 
-	return &ListElem{idstr, expr, i}, nil
+	return append([]*Expression{e}, exps...), nil
 }
\ No newline at end of file
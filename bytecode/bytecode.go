@@ -0,0 +1,171 @@
+// Package bytecode provides the binary format in which compiled programs are stored, so they can
+// be executed by the virtual machine without their source
+package bytecode
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/sdkvictor/golang-compiler/directories"
+	"github.com/sdkvictor/golang-compiler/gocc/token"
+	"github.com/sdkvictor/golang-compiler/mem"
+	"github.com/sdkvictor/golang-compiler/quad"
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// Constant is a value of the constant pool together with its address in the constant segment.
+// The value is a float64, rune, bool, int or string depending on the address
+type Constant struct {
+	Addr  mem.Address
+	Value interface{}
+}
+
+// Function is an entry of the function table, with the location of its first quad and the size of its activation record
+type Function struct {
+	Name string
+	Loc  mem.Address
+	Era  int
+}
+
+// Symbol is a variable of the program, Function is the one where it is declared or empty for globals.
+// Size is the amount of elements of a list and 0 for any other variable
+type Symbol struct {
+	Name     string
+	Function string
+	Addr     mem.Address
+	Size     int
+}
+
+// Position is a line and column of the source file, the line is 0 if it is unknown
+type Position struct {
+	Line   int
+	Column int
+}
+
+// Program is everything the virtual machine needs to run a compiled program. File, Symbols and
+// Positions are only used to debug it and to locate runtime errors, Positions has the source
+// position of each quad
+type Program struct {
+	File      string
+	Quads     []*quad.Quadruple
+	Constants []Constant
+	Functions []Function
+	Symbols   []Symbol
+	Positions []Position
+}
+
+// ParseConstant converts the literal of a constant to the value stored in its address
+func ParseConstant(cons string, addr mem.Address) (interface{}, error) {
+	switch {
+	case addr < mem.Constantstart:
+		return nil, errutil.NewNoPosf("Address %d is not in the constant segment", addr)
+	case addr < mem.Constantstart+mem.CharOffset: // Float
+		return strconv.ParseFloat(cons, 64)
+	case addr < mem.Constantstart+mem.BoolOffset: // Char
+		// The literal keeps its quotes so it does not share an address with an int or a string
+		r := []rune(cons)
+		if len(r) == 3 && r[0] == '\'' && r[2] == '\'' {
+			return r[1], nil
+		}
+		return r[0], nil
+	case addr < mem.Constantstart+mem.IntOffset: // Bool
+		return strconv.ParseBool(cons)
+	case addr < mem.Constantstart+mem.StringOffset: // Int
+		return strconv.Atoi(cons)
+	case addr < mem.Constantstart+mem.SquareOffset: // String
+		return strconv.Unquote(cons)
+	}
+
+	return nil, errutil.NewNoPosf("Address %d is not in the constant segment", addr)
+}
+
+// NewConstantPool converts the constant map of the compiler to a constant pool ordered by address
+func NewConstantPool(consmap map[string]int) ([]Constant, error) {
+	pool := make([]Constant, 0, len(consmap))
+
+	for cons, addr := range consmap {
+		v, err := ParseConstant(cons, mem.Address(addr))
+		if err != nil {
+			return nil, err
+		}
+
+		pool = append(pool, Constant{mem.Address(addr), v})
+	}
+
+	sort.Slice(pool, func(i, j int) bool {
+		return pool[i].Addr < pool[j].Addr
+	})
+
+	return pool, nil
+}
+
+// NewFunctionTable creates the function table of a function directory ordered by location
+func NewFunctionTable(funcdir *directories.FuncDirectory) []Function {
+	funcs := make([]Function, 0, len(funcdir.Table()))
+
+	for _, fe := range funcdir.Table() {
+		funcs = append(funcs, Function{fe.Id(), fe.Loc(), fe.Era()})
+	}
+
+	sort.Slice(funcs, func(i, j int) bool {
+		if funcs[i].Loc != funcs[j].Loc {
+			return funcs[i].Loc < funcs[j].Loc
+		}
+		return funcs[i].Name < funcs[j].Name
+	})
+
+	return funcs
+}
+
+// addSymbols appends the variables of a directory to the symbol table
+func addSymbols(symbols []Symbol, vardir *directories.VarDirectory, function string) []Symbol {
+	for _, ve := range vardir.Table() {
+		size := 0
+		if ve.Type().List() > 0 {
+			size = ve.Type().Elements()
+		}
+		symbols = append(symbols, Symbol{ve.Id(), function, ve.Address(), size})
+	}
+
+	return symbols
+}
+
+// NewSymbolTable creates the symbol table with the global variables and the ones of every function,
+// ordered by function and address
+func NewSymbolTable(funcdir *directories.FuncDirectory, globals *directories.VarDirectory) []Symbol {
+	symbols := addSymbols(make([]Symbol, 0), globals, "")
+
+	for _, fe := range funcdir.Table() {
+		symbols = addSymbols(symbols, fe.VarDir(), fe.Id())
+	}
+
+	sort.Slice(symbols, func(i, j int) bool {
+		if symbols[i].Function != symbols[j].Function {
+			return symbols[i].Function < symbols[j].Function
+		}
+		return symbols[i].Addr < symbols[j].Addr
+	})
+
+	return symbols
+}
+
+// NewPositions converts the positions of the tokens the quads were generated from
+func NewPositions(positions []token.Pos) []Position {
+	result := make([]Position, 0, len(positions))
+
+	for _, pos := range positions {
+		result = append(result, Position{pos.Line, pos.Column})
+	}
+
+	return result
+}
+
+// NewProgram creates the program with the result of the code generation of a source file
+func NewProgram(file string, quads []*quad.Quadruple, positions []token.Pos, consmap map[string]int, funcdir *directories.FuncDirectory, globals *directories.VarDirectory) (*Program, error) {
+	pool, err := NewConstantPool(consmap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Program{file, quads, pool, NewFunctionTable(funcdir), NewSymbolTable(funcdir, globals), NewPositions(positions)}, nil
+}
@@ -0,0 +1,198 @@
+package bytecode
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sdkvictor/golang-compiler/mem"
+	"github.com/sdkvictor/golang-compiler/quad"
+	"github.com/sdkvictor/golang-compiler/semantics"
+)
+
+// segments are the names of the memory segments, in the order of their start address
+var segments = []struct {
+	start mem.Address
+	name  string
+}{
+	{mem.Globalstart, "global"},
+	{mem.Localstart, "local"},
+	{mem.Tempstart, "temp"},
+	{mem.Constantstart, "const"},
+	{mem.Scopestart, "scope"},
+}
+
+// typeNames are the names of the types of a segment, in the order of their offset
+var typeNames = []string{"float", "char", "bool", "int", "string", "Square", "Circle", "Image", "Text", "Background", "struct", "list"}
+
+// AddressName resolves an address to its segment and type, for example local.float[2]. Object attributes
+// are shown with their name, like global.Square[0].x
+func AddressName(a mem.Address) string {
+	if a < 0 || a >= mem.Scopestart+5000 {
+		return fmt.Sprintf("%d", int(a))
+	}
+
+	seg := segments[0]
+	for _, s := range segments {
+		if a >= s.start {
+			seg = s
+		}
+	}
+
+	offset := int(a - seg.start)
+	t := offset / 1000
+	index := offset % 1000
+
+	if t >= len(typeNames) {
+		return fmt.Sprintf("%s.%d", seg.name, offset)
+	}
+
+	// Objects are stored in cells of ObjectSize, the first one is the object and the rest its attributes
+	if mem.Address(t*1000) >= mem.SquareOffset && mem.Address(t*1000) < mem.StructOffset {
+		att := index % semantics.ObjectSize
+		name := fmt.Sprintf("%s.%s[%d]", seg.name, typeNames[t], index/semantics.ObjectSize)
+		if att != 0 {
+			name += "." + semantics.ObjectAttributes[att-1]
+		}
+		return name
+	}
+
+	return fmt.Sprintf("%s.%s[%d]", seg.name, typeNames[t], index)
+}
+
+// Disassembler prints the quads of a program with symbolic names
+type Disassembler struct {
+	constants map[mem.Address]interface{}
+	functions map[mem.Address]string
+	labels    map[mem.Address]string
+}
+
+// operand shows an address, with the value of the constant if it is one
+func (d *Disassembler) operand(a mem.Address) string {
+	if a < 0 {
+		return "_"
+	}
+
+	v, ok := d.constants[a]
+	if !ok {
+		return AddressName(a)
+	}
+
+	switch c := v.(type) {
+	case string:
+		return strconv.Quote(c)
+	case rune:
+		return fmt.Sprintf("'%c'", c)
+	case float64:
+		if c == float64(int64(c)) {
+			return fmt.Sprintf("%.1f", c)
+		}
+		return fmt.Sprintf("%v", c)
+	}
+
+	return fmt.Sprintf("%v", v)
+}
+
+// number shows an operand that is not an address, like a size
+func (d *Disassembler) number(a mem.Address) string {
+	if a < 0 {
+		return "_"
+	}
+	return fmt.Sprintf("%d", int(a))
+}
+
+// typeCodeName shows the type code used by Init, which is the hexadecimal code of types.Type
+func typeCodeName(a mem.Address) string {
+	switch {
+	case a >= 1 && a <= 5:
+		return typeNames[a-1]
+	case a >= 7 && a <= 13:
+		return typeNames[a-2]
+	}
+	return fmt.Sprintf("%d", int(a))
+}
+
+// target shows the destination of a jump or a call
+func (d *Disassembler) target(a mem.Address) string {
+	if name, ok := d.functions[a]; ok {
+		return name
+	}
+	if label, ok := d.labels[a]; ok {
+		return label
+	}
+	return d.number(a)
+}
+
+// Operands returns the operands of the quad, each one shown according to how the operation uses it
+func (d *Disassembler) Operands(q *quad.Quadruple) []string {
+	switch q.Op() {
+	case quad.Goto:
+		return []string{"_", "_", d.target(q.R())}
+	case quad.GotoT, quad.GotoF:
+		return []string{d.operand(q.Lop()), "_", d.target(q.R())}
+	case quad.Call:
+		return []string{d.target(q.Lop()), "_", d.operand(q.R())}
+	case quad.Era:
+		return []string{d.number(q.Lop()), "_", "_"}
+	case quad.Param:
+		return []string{d.operand(q.Lop()), d.number(q.Rop()), "_"}
+	case quad.This:
+		return []string{d.operand(q.Lop()), "_", "_"}
+	case quad.Init:
+		return []string{d.operand(q.Lop()), d.number(q.Rop()), typeCodeName(q.R())}
+	case quad.CheckBound:
+		return []string{d.number(q.Lop()), "_", d.operand(q.R())}
+	}
+
+	return []string{d.operand(q.Lop()), d.operand(q.Rop()), d.operand(q.R())}
+}
+
+// Disassemble writes a line for each quad of the program, with the function entries and jump targets as labels
+func (d *Disassembler) Disassemble(quads []*quad.Quadruple) string {
+	var builder strings.Builder
+
+	for i, q := range quads {
+		addr := mem.Address(i)
+		if name, ok := d.functions[addr]; ok {
+			builder.WriteString(fmt.Sprintf("\n%s:\n", name))
+		}
+		if label, ok := d.labels[addr]; ok {
+			builder.WriteString(fmt.Sprintf("%s:\n", label))
+		}
+
+		ops := d.Operands(q)
+		builder.WriteString(fmt.Sprintf("  %4d  %-15s %s\n", i, q.Op(), strings.Join(ops, ", ")))
+	}
+
+	return builder.String()
+}
+
+// NewDisassembler creates a disassembler with the constants, functions and jump targets of the program
+func NewDisassembler(p *Program) *Disassembler {
+	d := &Disassembler{make(map[mem.Address]interface{}), make(map[mem.Address]string), make(map[mem.Address]string)}
+
+	for _, c := range p.Constants {
+		d.constants[c.Addr] = c.Value
+	}
+
+	for _, f := range p.Functions {
+		d.functions[f.Loc] = f.Name
+	}
+
+	// Labels are named after the quad they point to
+	for _, q := range p.Quads {
+		switch q.Op() {
+		case quad.Goto, quad.GotoT, quad.GotoF:
+			if _, ok := d.functions[q.R()]; !ok && q.R() >= 0 {
+				d.labels[q.R()] = fmt.Sprintf("L%d", int(q.R()))
+			}
+		}
+	}
+
+	return d
+}
+
+// Disassemble converts the whole program to a readable listing
+func Disassemble(p *Program) string {
+	return NewDisassembler(p).Disassemble(p.Quads)
+}
@@ -0,0 +1,347 @@
+package bytecode
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io/ioutil"
+	"math"
+
+	"github.com/sdkvictor/golang-compiler/mem"
+	"github.com/sdkvictor/golang-compiler/quad"
+	"github.com/mewkiz/pkg/errutil"
+)
+
+/*
+	Layout of a bytecode file, every number is little endian:
+
+	magic      4 bytes, "VIMO"
+	version    uint16
+	file       the name of the source file
+	constants  uvarint count, then for each one a kind byte, the address as an uvarint and the value
+	quads      uvarint count, then for each one the operation as an uvarint and its 3 addresses as varints
+	functions  uvarint count, then for each one its name, location as a varint and Era size as an uvarint
+	symbols    uvarint count, then for each one its name, function, address as a varint and size as an uvarint
+	positions  uvarint count, then the source line and column of each quad as uvarints
+	checksum   uint32, CRC-32 (IEEE) of everything before it
+
+	Strings are stored as their length in bytes as an uvarint followed by the bytes.
+*/
+
+// Magic are the first bytes of every bytecode file
+const Magic = "VIMO"
+
+// Version of the format written by Encode, Decode only accepts files of this version
+const Version = 5
+
+// Kinds of constants in the constant pool, their order is the one of the constant segment
+const (
+	kindFloat byte = iota
+	kindChar
+	kindBool
+	kindInt
+	kindString
+)
+
+type encoder struct {
+	buf bytes.Buffer
+	tmp [binary.MaxVarintLen64]byte
+}
+
+func (e *encoder) uvarint(v uint64) {
+	n := binary.PutUvarint(e.tmp[:], v)
+	e.buf.Write(e.tmp[:n])
+}
+
+func (e *encoder) varint(v int64) {
+	n := binary.PutVarint(e.tmp[:], v)
+	e.buf.Write(e.tmp[:n])
+}
+
+func (e *encoder) str(s string) {
+	e.uvarint(uint64(len(s)))
+	e.buf.WriteString(s)
+}
+
+func (e *encoder) constant(c Constant) error {
+	switch v := c.Value.(type) {
+	case float64:
+		e.buf.WriteByte(kindFloat)
+		e.uvarint(uint64(c.Addr))
+		binary.Write(&e.buf, binary.LittleEndian, math.Float64bits(v))
+	case rune:
+		e.buf.WriteByte(kindChar)
+		e.uvarint(uint64(c.Addr))
+		e.varint(int64(v))
+	case bool:
+		e.buf.WriteByte(kindBool)
+		e.uvarint(uint64(c.Addr))
+		if v {
+			e.buf.WriteByte(1)
+		} else {
+			e.buf.WriteByte(0)
+		}
+	case int:
+		e.buf.WriteByte(kindInt)
+		e.uvarint(uint64(c.Addr))
+		e.varint(int64(v))
+	case string:
+		e.buf.WriteByte(kindString)
+		e.uvarint(uint64(c.Addr))
+		e.str(v)
+	default:
+		return errutil.NewNoPosf("Cannot encode constant %v of type %T", c.Value, c.Value)
+	}
+
+	return nil
+}
+
+// Encode converts the program to the bytecode format
+func Encode(p *Program) ([]byte, error) {
+	e := &encoder{}
+
+	e.buf.WriteString(Magic)
+	binary.Write(&e.buf, binary.LittleEndian, uint16(Version))
+	e.str(p.File)
+
+	e.uvarint(uint64(len(p.Constants)))
+	for _, c := range p.Constants {
+		if err := e.constant(c); err != nil {
+			return nil, err
+		}
+	}
+
+	e.uvarint(uint64(len(p.Quads)))
+	for _, q := range p.Quads {
+		e.uvarint(uint64(q.Op()))
+		e.varint(int64(q.Lop()))
+		e.varint(int64(q.Rop()))
+		e.varint(int64(q.R()))
+	}
+
+	e.uvarint(uint64(len(p.Functions)))
+	for _, f := range p.Functions {
+		e.str(f.Name)
+		e.varint(int64(f.Loc))
+		e.uvarint(uint64(f.Era))
+	}
+
+	e.uvarint(uint64(len(p.Symbols)))
+	for _, sym := range p.Symbols {
+		e.str(sym.Name)
+		e.str(sym.Function)
+		e.varint(int64(sym.Addr))
+		e.uvarint(uint64(sym.Size))
+	}
+
+	e.uvarint(uint64(len(p.Positions)))
+	for _, pos := range p.Positions {
+		e.uvarint(uint64(pos.Line))
+		e.uvarint(uint64(pos.Column))
+	}
+
+	binary.Write(&e.buf, binary.LittleEndian, crc32.ChecksumIEEE(e.buf.Bytes()))
+
+	return e.buf.Bytes(), nil
+}
+
+type decoder struct {
+	r   *bytes.Reader
+	err error
+}
+
+func (d *decoder) fail(format string, args ...interface{}) {
+	if d.err == nil {
+		d.err = errutil.NewNoPosf(format, args...)
+	}
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+
+	v, err := binary.ReadUvarint(d.r)
+	if err != nil {
+		d.fail("Unexpected end of bytecode")
+	}
+	return v
+}
+
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+
+	v, err := binary.ReadVarint(d.r)
+	if err != nil {
+		d.fail("Unexpected end of bytecode")
+	}
+	return v
+}
+
+func (d *decoder) byte() byte {
+	if d.err != nil {
+		return 0
+	}
+
+	b, err := d.r.ReadByte()
+	if err != nil {
+		d.fail("Unexpected end of bytecode")
+	}
+	return b
+}
+
+// count reads the amount of entries of a section, which cannot be more than the remaining bytes
+func (d *decoder) count() int {
+	n := d.uvarint()
+	if n > uint64(d.r.Len()) {
+		d.fail("Invalid section size %d", n)
+		return 0
+	}
+	return int(n)
+}
+
+func (d *decoder) str() string {
+	n := d.count()
+	if d.err != nil {
+		return ""
+	}
+
+	b := make([]byte, n)
+	d.r.Read(b)
+	return string(b)
+}
+
+func (d *decoder) constant() Constant {
+	kind := d.byte()
+	addr := mem.Address(d.uvarint())
+
+	switch kind {
+	case kindFloat:
+		var bits uint64
+		if err := binary.Read(d.r, binary.LittleEndian, &bits); err != nil {
+			d.fail("Unexpected end of bytecode")
+		}
+		return Constant{addr, math.Float64frombits(bits)}
+	case kindChar:
+		return Constant{addr, rune(d.varint())}
+	case kindBool:
+		return Constant{addr, d.byte() != 0}
+	case kindInt:
+		return Constant{addr, int(d.varint())}
+	case kindString:
+		return Constant{addr, d.str()}
+	}
+
+	d.fail("Invalid constant kind %d", kind)
+	return Constant{}
+}
+
+// Decode rebuilds a program from the bytecode format, checking its version and checksum
+func Decode(data []byte) (*Program, error) {
+	if !IsBytecode(data) {
+		return nil, errutil.NewNoPosf("Not a bytecode file, missing magic number %q", Magic)
+	}
+
+	if len(data) < len(Magic)+2+4 {
+		return nil, errutil.NewNoPosf("Bytecode file is too short")
+	}
+
+	version := binary.LittleEndian.Uint16(data[len(Magic):])
+	if version != Version {
+		return nil, errutil.NewNoPosf("Bytecode version %d is not supported, expected version %d. Build the program again", version, Version)
+	}
+
+	body := data[:len(data)-4]
+	sum := binary.LittleEndian.Uint32(data[len(data)-4:])
+	if crc32.ChecksumIEEE(body) != sum {
+		return nil, errutil.NewNoPosf("Bytecode checksum does not match, the file is corrupted")
+	}
+
+	d := &decoder{bytes.NewReader(body[len(Magic)+2:]), nil}
+	p := &Program{}
+	p.File = d.str()
+
+	n := d.count()
+	p.Constants = make([]Constant, 0, n)
+	for i := 0; i < n && d.err == nil; i++ {
+		p.Constants = append(p.Constants, d.constant())
+	}
+
+	n = d.count()
+	p.Quads = make([]*quad.Quadruple, 0, n)
+	for i := 0; i < n && d.err == nil; i++ {
+		op := quad.Operation(d.uvarint())
+		if op >= quad.Invalid {
+			d.fail("Invalid operation %d in quad %d", op, i)
+		}
+
+		a1 := mem.Address(d.varint())
+		a2 := mem.Address(d.varint())
+		r := mem.Address(d.varint())
+		p.Quads = append(p.Quads, quad.NewQuadruple(op, a1, a2, r))
+	}
+
+	n = d.count()
+	p.Functions = make([]Function, 0, n)
+	for i := 0; i < n && d.err == nil; i++ {
+		name := d.str()
+		loc := mem.Address(d.varint())
+		era := int(d.uvarint())
+		p.Functions = append(p.Functions, Function{name, loc, era})
+	}
+
+	n = d.count()
+	p.Symbols = make([]Symbol, 0, n)
+	for i := 0; i < n && d.err == nil; i++ {
+		name := d.str()
+		function := d.str()
+		addr := mem.Address(d.varint())
+		size := int(d.uvarint())
+		p.Symbols = append(p.Symbols, Symbol{name, function, addr, size})
+	}
+
+	n = d.count()
+	p.Positions = make([]Position, 0, n)
+	for i := 0; i < n && d.err == nil; i++ {
+		line := int(d.uvarint())
+		column := int(d.uvarint())
+		p.Positions = append(p.Positions, Position{line, column})
+	}
+
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	if d.r.Len() != 0 {
+		return nil, errutil.NewNoPosf("Unexpected %d bytes at the end of the bytecode", d.r.Len())
+	}
+
+	return p, nil
+}
+
+// IsBytecode checks if the data starts with the magic number of the format
+func IsBytecode(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(Magic))
+}
+
+// WriteFile encodes the program to the file in path
+func WriteFile(path string, p *Program) error {
+	data, err := Encode(p)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// ReadFile decodes the program in the file of path
+func ReadFile(path string) (*Program, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Decode(data)
+}
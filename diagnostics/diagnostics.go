@@ -0,0 +1,255 @@
+// Package diagnostics provides the errors and warnings found while compiling a program, located in
+// its source file so they can be shown with the line where they happen
+package diagnostics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sdkvictor/golang-compiler/gocc/errors"
+	"github.com/sdkvictor/golang-compiler/gocc/token"
+)
+
+// Severity tells if a diagnostic stops the compilation
+type Severity int
+
+const (
+	Error Severity = iota
+	Warning
+	Note
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Error:
+		return "error"
+	case Warning:
+		return "warning"
+	case Note:
+		return "note"
+	}
+	return "unknown"
+}
+
+// Diagnostic is a problem of the program. Line and Column start at 1, the line is 0 if the
+// problem is not in a specific place of the source. Length is the amount of characters marked
+type Diagnostic struct {
+	Severity Severity
+	Code     string
+	Message  string
+	File     string
+	Line     int
+	Column   int
+	Length   int
+}
+
+// Error shows the diagnostic in a single line as file:line:col: severity[code]: message
+func (d *Diagnostic) Error() string {
+	var builder strings.Builder
+
+	if d.File != "" {
+		builder.WriteString(d.File + ":")
+	}
+	if d.Line > 0 {
+		builder.WriteString(fmt.Sprintf("%d:%d:", d.Line, d.Column))
+	}
+	if builder.Len() > 0 {
+		builder.WriteString(" ")
+	}
+
+	builder.WriteString(d.header())
+	return builder.String()
+}
+
+// header is the severity, code and message of the diagnostic
+func (d *Diagnostic) header() string {
+	if d.Code == "" {
+		return fmt.Sprintf("%s: %s", d.Severity, d.Message)
+	}
+	return fmt.Sprintf("%s[%s]: %s", d.Severity, d.Code, d.Message)
+}
+
+// Format shows the diagnostic with the line of the source where it is and a caret under it
+func (d *Diagnostic) Format(source []byte) string {
+	var builder strings.Builder
+
+	builder.WriteString(d.header() + "\n")
+
+	if d.Line == 0 {
+		if d.File != "" {
+			builder.WriteString(fmt.Sprintf(" --> %s\n", d.File))
+		}
+		return builder.String()
+	}
+
+	builder.WriteString(fmt.Sprintf(" --> %s:%d:%d\n", d.File, d.Line, d.Column))
+
+	lines := strings.Split(string(source), "\n")
+	if d.Line > len(lines) {
+		return builder.String()
+	}
+
+	line := strings.TrimRight(lines[d.Line-1], "\r")
+	number := fmt.Sprintf("%d", d.Line)
+	gutter := strings.Repeat(" ", len(number))
+
+	// Tabs before the column are kept so the caret is aligned with the line
+	var indent strings.Builder
+	for i, c := range []rune(line) {
+		if i >= d.Column-1 {
+			break
+		}
+		if c == '\t' {
+			indent.WriteRune('\t')
+		} else {
+			indent.WriteRune(' ')
+		}
+	}
+
+	length := d.Length
+	if length < 1 {
+		length = 1
+	}
+
+	builder.WriteString(fmt.Sprintf("%s |\n", gutter))
+	builder.WriteString(fmt.Sprintf("%s | %s\n", number, line))
+	builder.WriteString(fmt.Sprintf("%s | %s%s\n", gutter, indent.String(), strings.Repeat("^", length)))
+
+	return builder.String()
+}
+
+// New creates a diagnostic located at a token, which can be nil if it has no location
+func New(severity Severity, code string, tok *token.Token, format string, args ...interface{}) *Diagnostic {
+	d := &Diagnostic{severity, code, fmt.Sprintf(format, args...), "", 0, 0, 0}
+	if tok != nil {
+		d.Line = tok.Pos.Line
+		d.Column = tok.Pos.Column
+		d.Length = len([]rune(string(tok.Lit)))
+	}
+	return d
+}
+
+// Errorf creates an error located at a token
+func Errorf(code string, tok *token.Token, format string, args ...interface{}) *Diagnostic {
+	return New(Error, code, tok, format, args...)
+}
+
+// List is every diagnostic found in a program, it is used as the error of a compilation that fails
+type List []*Diagnostic
+
+// Add appends an error to the list, converting it to a diagnostic without location if it is not one
+func (l *List) Add(err error) {
+	if d, ok := err.(*Diagnostic); ok {
+		*l = append(*l, d)
+		return
+	}
+
+	if list, ok := err.(List); ok {
+		*l = append(*l, list...)
+		return
+	}
+
+	*l = append(*l, &Diagnostic{Error, "", err.Error(), "", 0, 0, 0})
+}
+
+// Errors counts the diagnostics that are errors
+func (l List) Errors() int {
+	count := 0
+	for _, d := range l {
+		if d.Severity == Error {
+			count++
+		}
+	}
+	return count
+}
+
+// Err returns the list as an error if it has at least one error, nil otherwise
+func (l List) Err() error {
+	if l.Errors() == 0 {
+		return nil
+	}
+	return l
+}
+
+// Sort orders the diagnostics by their position in the source, the ones without a position go last
+func (l List) Sort() {
+	sort.SliceStable(l, func(i, j int) bool {
+		a, b := l[i], l[j]
+		if (a.Line == 0) != (b.Line == 0) {
+			return b.Line == 0
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+}
+
+// SetFile sets the source file of every diagnostic of the list
+func (l List) SetFile(file string) {
+	for _, d := range l {
+		d.File = file
+	}
+}
+
+// Error shows a diagnostic per line
+func (l List) Error() string {
+	lines := make([]string, 0, len(l))
+	for _, d := range l {
+		lines = append(lines, d.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Format shows every diagnostic with its source line, followed by the amount of errors found
+func (l List) Format(source []byte) string {
+	var builder strings.Builder
+
+	for _, d := range l {
+		builder.WriteString(d.Format(source) + "\n")
+	}
+
+	switch count := l.Errors(); {
+	case count == 1:
+		builder.WriteString("error: aborting due to previous error\n")
+	case count > 1:
+		builder.WriteString(fmt.Sprintf("error: aborting due to %d previous errors\n", count))
+	}
+
+	return builder.String()
+}
+
+// ErrSyntax is the code of the errors of the parser
+const ErrSyntax = "E0001"
+
+// FromParseError converts an error of the parser to a diagnostic located at the token where it happened,
+// a diagnostic of the tree keeps its own position and any other error is returned as it is
+func FromParseError(err error) error {
+	perr, ok := err.(*errors.Error)
+	if !ok || perr.ErrorToken == nil {
+		return err
+	}
+
+	if d, ok := perr.Err.(*Diagnostic); ok {
+		return List{d}
+	}
+
+	if perr.Err != nil {
+		return List{Errorf(ErrSyntax, perr.ErrorToken, "%v", perr.Err)}
+	}
+
+	// A string can not span lines, the lexer stops at the end of the line of the opening quote
+	lit := string(perr.ErrorToken.Lit)
+	if perr.ErrorToken.Type == token.INVALID && strings.HasPrefix(lit, "\"") {
+		tok := &token.Token{Type: perr.ErrorToken.Type, Lit: []byte(strings.TrimRight(lit, "\r\n")), Pos: perr.ErrorToken.Pos}
+		return List{Errorf(ErrSyntax, tok, "Unterminated string")}
+	}
+
+	found := fmt.Sprintf("%q", perr.ErrorToken.Lit)
+	if perr.ErrorToken.Type == token.EOF {
+		found = "end of file"
+	}
+
+	return List{Errorf(ErrSyntax, perr.ErrorToken, "Unexpected %s, expected one of: %s", found, strings.Join(perr.ExpectedTokens, " "))}
+}
@@ -0,0 +1,58 @@
+package diagnostics
+
+import (
+	"testing"
+
+	"github.com/sdkvictor/golang-compiler/gocc/errors"
+	"github.com/sdkvictor/golang-compiler/gocc/token"
+)
+
+func TestFormat(t *testing.T) {
+	source := []byte("program Test;\n\tx = y +  z;\n")
+
+	tests := []struct {
+		diag    *Diagnostic
+		expects string
+	}{
+		{&Diagnostic{Error, "E0202", "Id z not declared", "test.vm", 2, 11, 1}, "error[E0202]: Id z not declared\n" +
+			" --> test.vm:2:11\n" +
+			"  |\n" +
+			"2 | \tx = y +  z;\n" +
+			"  | \t         ^\n"},
+		{&Diagnostic{Warning, "", "Unused variable", "test.vm", 1, 9, 4}, "warning: Unused variable\n" +
+			" --> test.vm:1:9\n" +
+			"  |\n" +
+			"1 | program Test;\n" +
+			"  |         ^^^^\n"},
+		{&Diagnostic{Error, "E0307", "Main function not declared in program", "test.vm", 0, 0, 0}, "error[E0307]: Main function not declared in program\n" +
+			" --> test.vm\n"},
+	}
+
+	for _, test := range tests {
+		if out := test.diag.Format(source); out != test.expects {
+			t.Errorf("Expected:\n%s\ngot:\n%s", test.expects, out)
+		}
+	}
+
+	list := List{tests[0].diag, tests[1].diag}
+	list.Sort()
+	if list[0] != tests[1].diag || list.Errors() != 1 || list.Err() == nil {
+		t.Errorf("Unexpected order or count of errors in %v", list)
+	}
+}
+
+func TestFromParseError(t *testing.T) {
+	literal := &token.Token{Lit: []byte(`"bad \u{D800}"`), Pos: token.Pos{Offset: 8, Line: 2, Column: 9}}
+	lookahead := &token.Token{Lit: []byte(";"), Pos: token.Pos{Offset: 24, Line: 2, Column: 25}}
+
+	// A diagnostic returned while building the tree is located at its own token, not at the lookahead
+	err := FromParseError(&errors.Error{Err: Errorf(ErrSyntax, literal, "Invalid unicode code point D800 in string"), ErrorToken: lookahead})
+	if list, ok := err.(List); !ok || len(list) != 1 || list[0].Error() != "2:9: error[E0001]: Invalid unicode code point D800 in string" {
+		t.Errorf("Unexpected diagnostic %v", err)
+	}
+
+	err = FromParseError(&errors.Error{ErrorToken: lookahead, ExpectedTokens: []string{"id"}})
+	if list, ok := err.(List); !ok || len(list) != 1 || list[0].Error() != "2:25: error[E0001]: Unexpected \";\", expected one of: id" {
+		t.Errorf("Unexpected diagnostic %v", err)
+	}
+}
@@ -14,6 +14,8 @@ type VarEntry struct {
 	tok  *token.Token
 	addr mem.Address
 	pos  int
+	constant bool
+	value    string
 }
 
 func (ve *VarEntry) Id() string {
@@ -44,6 +46,24 @@ func (ve *VarEntry) SetPos(i int) {
 	ve.pos = i
 }
 
+// IsConstant tells if the variable was declared with const, so it cannot be assigned
+func (ve *VarEntry) IsConstant() bool {
+	return ve.constant
+}
+
+func (ve *VarEntry) SetConstant() {
+	ve.constant = true
+}
+
+// Value is the literal of a constant, folded when its declaration is checked
+func (ve *VarEntry) Value() string {
+	return ve.value
+}
+
+func (ve *VarEntry) SetValue(v string) {
+	ve.value = v
+}
+
 type VarDirectory struct {
 	table map[string]*VarEntry
 }
@@ -54,7 +74,7 @@ func (e *VarEntry) String() string {
 */
 //NewVarEntry Initialization of one entry of the variable with its attributes
 func NewVarEntry(id string, t *types.Type, tok *token.Token, pos int) *VarEntry {
-	return &VarEntry{id, t, tok, 0, pos}
+	return &VarEntry{id, t, tok, 0, pos, false, ""}
 }
 
 //Add Add a varentry to the directory variables using the toString function as key
@@ -1,36 +1,43 @@
+// Package engine defines the interfaces the virtual machine uses to draw objects and read
+// user input, together with the backends that do not need a graphics context
 package engine
 
-// importar pixel
 import (
-	"image"
-	"os"
-	"fmt"
 	"strconv"
 
-	_ "image/png"
-
 	"github.com/sdkvictor/golang-compiler/objects"
 
 	"github.com/faiface/pixel"
-	"github.com/faiface/pixel/pixelgl"
-	"github.com/faiface/pixel/imdraw"
-	"github.com/faiface/pixel/text"
-	"golang.org/x/image/font/basicfont"
-	"golang.org/x/image/colornames"
-	"github.com/nfnt/resize"
 )
 
-/*
-const (
-	windowWidth  = 1024
-	windowHeight = 768
-)
-*/
+// Renderer draws the predefined objects and controls the frames of the game
+type Renderer interface {
+	DrawSquare(s objects.Square)
+	DrawCircle(c objects.Circle)
+	DrawText(t objects.Text)
+	DrawImage(i objects.Image)
+	Clear()
+	Update()
+	WindowClosed() bool
+}
 
-type Engine struct {
-	win *pixelgl.Window
-	imd *imdraw.IMDraw
+// Input reports the state of the keys and mouse buttons of the game
+type Input interface {
+	KeyPressed(k string) bool
+}
+
+// Engine is everything the virtual machine needs to run a game
+type Engine interface {
+	Renderer
+	Input
+}
 
+// Keys are the names of the keys and mouse buttons that KeyPressed can check
+var Keys = []string{
+	"Space", "Up", "Down", "Right", "Left",
+	"W", "A", "S", "D", "Q", "E", "R", "T", "Y", "U", "I", "O", "P",
+	"F", "G", "H", "J", "K", "L", "Z", "X", "C", "V", "B", "N", "M",
+	"Enter", "Backspace", "Esc", "MouseLeft",
 }
 
 type RGB struct {
@@ -39,14 +46,10 @@ type RGB struct {
 	Blue  uint8
 }
 
-
-func toRGB(h string) (RGB, error) {
-	return Hex2RGB(h)
-}
-
+// Hex2RGB converts a hexadecimal color such as ffffff to its RGB components
 func Hex2RGB(hex string) (RGB, error) {
 	var rgb RGB
-	values, err := strconv.ParseUint(hex[1:len(hex)-1], 16, 32)
+	values, err := strconv.ParseUint(hex, 16, 32)
 
 	if err != nil {
 		return RGB{}, err
@@ -61,198 +64,34 @@ func Hex2RGB(hex string) (RGB, error) {
 	return rgb, nil
 }
 
+// IntersectSquare checks if the bounds of two squares overlap
+func IntersectSquare(s1, s2 objects.Square) bool {
+	r1 := pixel.R(s1.X(), s1.Y(), s1.X()+s1.Width(), s1.Y()+s1.Height())
 
-func loadPicture(path string, i objects.Image) (pixel.Picture, error) {
-	f, err := os.Open(path)
-	if err != nil {
-		return nil, err
-	}
-	defer f.Close()
+	r2 := pixel.R(s2.X(), s2.Y(), s2.X()+s2.Width(), s2.Y()+s2.Height())
 
-	img, _, err := image.Decode(f)
-	if err != nil {
-		return nil, err
-	}
-	newImage := resize.Resize(uint(i.Width()), uint(i.Height()), img, resize.Lanczos3)
-	return pixel.PictureDataFromImage(newImage), nil
-}
-
-func (e *Engine) DrawSquare(s objects.Square) {
-	c, _:= toRGB(s.Color())
-	e.imd.Color = pixel.RGB(float64(c.Red)/255, float64(c.Green)/255, float64(c.Blue)/255)
-	r := pixel.R(s.X(), s.Y(), s.X()+s.Width(), s.Y()+s.Height())
-	e.imd.Push(r.Min, r.Max)
-	e.imd.Rectangle(0)
-	e.imd.Draw(e.win)
-}
-
-func (e *Engine) DrawCircle(c objects.Circle) {
-	r,_ := toRGB(c.Color())
-	e.imd.Color = pixel.RGB(float64(r.Red)/255, float64(r.Green)/255, float64(r.Blue)/255)
-	e.imd.Push(pixel.V(c.X(), c.Y()))
-	e.imd.Ellipse(pixel.V(c.Width()/2, c.Height()/2), 0)
-	e.imd.Draw(e.win)
-}
-
-func (e *Engine) DrawText(t objects.Text) {
-	basicAtlas := text.NewAtlas(basicfont.Face7x13, text.ASCII)
-	basicTxt := text.New(pixel.V(t.X(), t.Y()), basicAtlas)
-
-	fmt.Fprintln(basicTxt, t.Message()[1:len(t.Message())-1])
-	basicTxt.Draw(e.win, pixel.IM)
-}
-
-func (e *Engine) DrawImage(i objects.Image) {
-	img := string(i.Image())
-	pic, err := loadPicture(img[1:len(img)-1], i)
-	if err != nil {
-		panic(err)
-	}
-
-	sprite := pixel.NewSprite(pic, pic.Bounds())
-	sprite.Draw(e.win, pixel.IM.Moved(pixel.V(i.X(), i.Y())))
-}
-
-func (e *Engine) WindowClosed() bool {
-	return e.win.Closed()
+	return r1.Intersects(r2)
 }
 
+// IntersectCircle checks if the bounds of two circles overlap
+func IntersectCircle(s1, s2 objects.Circle) bool {
+	r1 := pixel.R(s1.X(), s1.Y(), s1.X()+s1.Width(), s1.Y()+s1.Height())
 
-func (e *Engine) KeyPressed(k string) bool {
-	key := k[1:len(k)-1]
-	switch key {
-	case "Space":
-		return e.win.Pressed(pixelgl.KeySpace)
-	case "Up":
-		return e.win.Pressed(pixelgl.KeyUp)	
-	case "Down":
-		return e.win.Pressed(pixelgl.KeyDown)
-	case "Right":
-		return e.win.Pressed(pixelgl.KeyRight)
-	case "Left":
-		return e.win.Pressed(pixelgl.KeyLeft)
-	case "W":
-		return e.win.Pressed(pixelgl.KeyW)
-	case "A":
-		return e.win.Pressed(pixelgl.KeyA)
-	case "S":
-		return e.win.Pressed(pixelgl.KeyS)
-	case "D":
-		return e.win.Pressed(pixelgl.KeyD)
-	case "Q":
-		return e.win.Pressed(pixelgl.KeyQ)
-	case "E":
-		return e.win.Pressed(pixelgl.KeyE)
-	case "R":
-		return e.win.Pressed(pixelgl.KeyR)
-	case "T":
-		return e.win.Pressed(pixelgl.KeyT)
-	case "Y":
-		return e.win.Pressed(pixelgl.KeyY)
-	case "U":
-		return e.win.Pressed(pixelgl.KeyU)
-	case "I":
-		return e.win.Pressed(pixelgl.KeyI)
-	case "O":
-		return e.win.Pressed(pixelgl.KeyO)
-	case "P":
-		return e.win.Pressed(pixelgl.KeyP)
-	case "F":
-		return e.win.Pressed(pixelgl.KeyF)
-	case "G":
-		return e.win.Pressed(pixelgl.KeyG)
-	case "H":
-		return e.win.Pressed(pixelgl.KeyH)
-	case "J":
-		return e.win.Pressed(pixelgl.KeyJ)
-	case "K":
-		return e.win.Pressed(pixelgl.KeyK)
-	case "L":
-		return e.win.Pressed(pixelgl.KeyL)
-	case "Z":
-		return e.win.Pressed(pixelgl.KeyZ)
-	case "X":
-		return e.win.Pressed(pixelgl.KeyX)
-	case "C":
-		return e.win.Pressed(pixelgl.KeyC)
-	case "V":
-		return e.win.Pressed(pixelgl.KeyV)
-	case "B":
-		return e.win.Pressed(pixelgl.KeyB)
-	case "N":
-		return e.win.Pressed(pixelgl.KeyN)
-	case "M":
-		return e.win.Pressed(pixelgl.KeyM)
-	case "Enter":
-		return e.win.Pressed(pixelgl.KeyEnter)
-	case "Backspace":
-		return e.win.Pressed(pixelgl.KeyBackspace)
-	case "Esc":
-		return e.win.Pressed(pixelgl.KeyEscape)
-	case "MouseLeft":
-		return e.win.JustPressed(pixelgl.MouseButtonLeft)
-	}
-	return false;
-}
-
-func (e *Engine) IntersectSquare(s1, s2 objects.Square) bool{
-	r1 := pixel.R(s1.X(), s1.Y(), s1.X() + s1.Width(), s1.Y() + s1.Height())
-	
-	r2 := pixel.R(s2.X(), s2.Y(), s2.X() + s2.Width(), s2.Y() + s2.Height())
-	
-	return r1.Intersects(r2)
-}
+	r2 := pixel.R(s2.X(), s2.Y(), s2.X()+s2.Width(), s2.Y()+s2.Height())
 
-func (e *Engine) IntersectCircle(s1, s2 objects.Circle) bool{
-	r1 := pixel.R(s1.X(), s1.Y(), s1.X() + s1.Width(), s1.Y() + s1.Height())
-	
-	r2 := pixel.R(s2.X(), s2.Y(), s2.X() + s2.Width(), s2.Y() + s2.Height())
-	
 	return r1.Intersects(r2)
 }
 
-func (e *Engine) IntersectCS(s1 objects.Circle, s2 objects.Square) bool{
-	r1 := pixel.R(s1.X(), s1.Y(), s1.X() + s1.Width(), s1.Y() + s1.Height())
-	//fmt.Printf(r1.String())
-	r2 := pixel.R(s2.X(), s2.Y(), s2.X() + s2.Width(), s2.Y() + s2.Height())
-	//fmt.Printf(r2.String())
-	return r1.Intersects(r2)
+// IntersectCS checks if the bounds of a circle and a square overlap
+func IntersectCS(s1 objects.Circle, s2 objects.Square) bool {
+	r1 := pixel.R(s1.X(), s1.Y(), s1.X()+s1.Width(), s1.Y()+s1.Height())
 
-}
+	r2 := pixel.R(s2.X(), s2.Y(), s2.X()+s2.Width(), s2.Y()+s2.Height())
 
-func (e *Engine) IntersectSC(s1 objects.Square, s2 objects.Circle) bool{
-	r1 := pixel.R(s1.X(), s1.Y(), s1.X() + s1.Width(), s1.Y() + s1.Height())
-	
-	r2 := pixel.R(s2.X(), s2.Y(), s2.X() + s2.Width(), s2.Y() + s2.Height())
-	
 	return r1.Intersects(r2)
 }
 
-func (e *Engine) Clear() {
-	e.win.Clear(colornames.Black)
-	e.imd.Clear()
-	//e.imd.Reset()
-}
-
-func (e *Engine) Update() {
-	e.win.Update()
+// IntersectSC checks if the bounds of a square and a circle overlap
+func IntersectSC(s1 objects.Square, s2 objects.Circle) bool {
+	return IntersectCS(s2, s1)
 }
-
-func NewEngine(name string, windowWidth float64, windowHeight float64) *Engine {
-	cfg := pixelgl.WindowConfig{
-        Title:  name,
-        Bounds: pixel.R(0, 0, windowWidth, windowHeight),
-        VSync:  true,
-    }
-    win, err := pixelgl.NewWindow(cfg)
-    if err != nil {
-        panic(err)
-    }
-
-	win.Clear(colornames.Black)
-
-	return &Engine{
-		win,
-		imdraw.New(nil),
-	}
-}
\ No newline at end of file
@@ -0,0 +1,67 @@
+package engine
+
+import (
+	"image"
+	"os"
+	"path/filepath"
+
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// ReadPNG decodes the image in the file of path
+func ReadPNG(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, err
+	}
+
+	return img, nil
+}
+
+// CompareGolden checks that a frame is identical to the golden image in path. If update is true
+// the golden image is replaced by the frame instead, which is how golden files are created
+func CompareGolden(frame image.Image, path string, update bool) error {
+	if update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		return WritePNG(frame, path)
+	}
+
+	golden, err := ReadPNG(path)
+	if err != nil {
+		return err
+	}
+
+	if !frame.Bounds().Eq(golden.Bounds()) {
+		return errutil.NewNoPosf("Frame of size %v does not match golden %s of size %v", frame.Bounds().Size(), path, golden.Bounds().Size())
+	}
+
+	diff := 0
+	first := image.Point{-1, -1}
+	b := frame.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			r1, g1, b1, a1 := frame.At(x, y).RGBA()
+			r2, g2, b2, a2 := golden.At(x, y).RGBA()
+			if r1 != r2 || g1 != g2 || b1 != b2 || a1 != a2 {
+				if diff == 0 {
+					first = image.Point{x, y}
+				}
+				diff++
+			}
+		}
+	}
+
+	if diff > 0 {
+		return errutil.NewNoPosf("Frame differs from golden %s in %d pixels, the first one at %v", path, diff, first)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package engine
+
+import (
+	"github.com/sdkvictor/golang-compiler/objects"
+)
+
+// Headless is an engine without a window. Drawing does nothing and no key is ever pressed,
+// which allows programs to run where there is no graphics context available
+type Headless struct {
+	frame     int
+	maxFrames int
+}
+
+// Frame returns the amount of frames that have been updated
+func (h *Headless) Frame() int {
+	return h.frame
+}
+
+func (h *Headless) DrawSquare(s objects.Square) {}
+
+func (h *Headless) DrawCircle(c objects.Circle) {}
+
+func (h *Headless) DrawText(t objects.Text) {}
+
+func (h *Headless) DrawImage(i objects.Image) {}
+
+func (h *Headless) Clear() {}
+
+func (h *Headless) Update() {
+	h.frame++
+}
+
+// WindowClosed reports the window as closed once the maximum amount of frames
+// has been reached. A maximum of 0 never closes the window
+func (h *Headless) WindowClosed() bool {
+	return h.maxFrames > 0 && h.frame >= h.maxFrames
+}
+
+func (h *Headless) KeyPressed(k string) bool {
+	return false
+}
+
+// NewHeadless creates a headless engine that stops the program after maxFrames updates
+func NewHeadless(maxFrames int) *Headless {
+	return &Headless{0, maxFrames}
+}
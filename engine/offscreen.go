@@ -0,0 +1,191 @@
+package engine
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"os"
+	"path/filepath"
+
+	"github.com/sdkvictor/golang-compiler/objects"
+
+	"github.com/nfnt/resize"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Offscreen is an engine that rasterizes the objects into an in memory image instead of a window.
+// The coordinates follow the ones of the window engine, the origin is the bottom left corner
+type Offscreen struct {
+	canvas    *image.RGBA
+	frame     int
+	maxFrames int
+	dir       string
+	err       error
+}
+
+// Canvas returns the image with the objects drawn since the last Clear
+func (o *Offscreen) Canvas() *image.RGBA {
+	return o.canvas
+}
+
+// Frame returns the amount of frames that have been updated
+func (o *Offscreen) Frame() int {
+	return o.frame
+}
+
+// Err returns the first error found while drawing or writing a frame
+func (o *Offscreen) Err() error {
+	return o.err
+}
+
+// FramePath returns the path where the given frame is written
+func (o *Offscreen) FramePath(frame int) string {
+	return filepath.Join(o.dir, fmt.Sprintf("frame%05d.png", frame))
+}
+
+// toCanvas converts a point of the window coordinates to the canvas coordinates
+func (o *Offscreen) toCanvas(x, y float64) (int, int) {
+	return int(x), o.canvas.Bounds().Dy() - int(y)
+}
+
+func (o *Offscreen) setErr(err error) {
+	if o.err == nil {
+		o.err = err
+	}
+}
+
+func toColor(h string) (color.RGBA, error) {
+	c, err := Hex2RGB(h)
+	if err != nil {
+		return color.RGBA{}, err
+	}
+	return color.RGBA{c.Red, c.Green, c.Blue, 0xff}, nil
+}
+
+func (o *Offscreen) DrawSquare(s objects.Square) {
+	c, err := toColor(s.Color())
+	if err != nil {
+		o.setErr(err)
+		return
+	}
+
+	x0, y0 := o.toCanvas(s.X(), s.Y()+s.Height())
+	x1, y1 := o.toCanvas(s.X()+s.Width(), s.Y())
+
+	draw.Draw(o.canvas, image.Rect(x0, y0, x1, y1), image.NewUniform(c), image.Point{}, draw.Over)
+}
+
+func (o *Offscreen) DrawCircle(c objects.Circle) {
+	col, err := toColor(c.Color())
+	if err != nil {
+		o.setErr(err)
+		return
+	}
+
+	rx := c.Width() / 2
+	ry := c.Height() / 2
+	if rx <= 0 || ry <= 0 {
+		return
+	}
+
+	x0, y0 := o.toCanvas(c.X()-rx, c.Y()+ry)
+	x1, y1 := o.toCanvas(c.X()+rx, c.Y()-ry)
+	bounds := image.Rect(x0, y0, x1, y1).Intersect(o.canvas.Bounds())
+
+	// A pixel is filled if its center lies inside of the ellipse
+	cx, cy := c.X(), float64(o.canvas.Bounds().Dy())-c.Y()
+	for py := bounds.Min.Y; py < bounds.Max.Y; py++ {
+		for px := bounds.Min.X; px < bounds.Max.X; px++ {
+			dx := (float64(px) + 0.5 - cx) / rx
+			dy := (float64(py) + 0.5 - cy) / ry
+			if dx*dx+dy*dy <= 1 {
+				o.canvas.SetRGBA(px, py, col)
+			}
+		}
+	}
+}
+
+func (o *Offscreen) DrawText(t objects.Text) {
+	x, y := o.toCanvas(t.X(), t.Y())
+	d := &font.Drawer{
+		Dst:  o.canvas,
+		Src:  image.White,
+		Face: basicfont.Face7x13,
+		Dot:  fixed.P(x, y),
+	}
+	d.DrawString(t.Message())
+}
+
+func (o *Offscreen) DrawImage(i objects.Image) {
+	f, err := os.Open(i.Image())
+	if err != nil {
+		o.setErr(err)
+		return
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	if err != nil {
+		o.setErr(err)
+		return
+	}
+	img = resize.Resize(uint(i.Width()), uint(i.Height()), img, resize.Lanczos3)
+
+	// The image is centered on its position, like a sprite of the window engine
+	size := img.Bounds().Size()
+	x, y := o.toCanvas(i.X(), i.Y())
+	r := image.Rect(x-size.X/2, y-size.Y/2, x-size.X/2+size.X, y-size.Y/2+size.Y)
+
+	draw.Draw(o.canvas, r, img, img.Bounds().Min, draw.Over)
+}
+
+func (o *Offscreen) Clear() {
+	draw.Draw(o.canvas, o.canvas.Bounds(), image.Black, image.Point{}, draw.Src)
+}
+
+// Update finishes the current frame, writing it as a numbered PNG if the engine has an output directory
+func (o *Offscreen) Update() {
+	if o.dir != "" {
+		o.setErr(WritePNG(o.canvas, o.FramePath(o.frame)))
+	}
+	o.frame++
+}
+
+// WindowClosed reports the window as closed once the maximum amount of frames
+// has been reached or after an error. A maximum of 0 never closes the window
+func (o *Offscreen) WindowClosed() bool {
+	return o.err != nil || (o.maxFrames > 0 && o.frame >= o.maxFrames)
+}
+
+func (o *Offscreen) KeyPressed(k string) bool {
+	return false
+}
+
+// WritePNG encodes an image to the file in path
+func WritePNG(img image.Image, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = png.Encode(f, img)
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// NewOffscreen creates an offscreen engine of the given size that stops the program after maxFrames updates.
+// If dir is not empty every frame is written to it as a PNG file
+func NewOffscreen(width, height, maxFrames int, dir string) *Offscreen {
+	canvas := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(canvas, canvas.Bounds(), image.Black, image.Point{}, draw.Src)
+
+	return &Offscreen{canvas, 0, maxFrames, dir, nil}
+}
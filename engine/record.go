@@ -0,0 +1,75 @@
+package engine
+
+import (
+	"fmt"
+	"os"
+)
+
+// Recorder is an engine that writes every change in the state of the keys of another engine to a
+// session file. The session uses the format of Script, so it can be replayed with a Playback engine
+type Recorder struct {
+	Engine
+	file    *os.File
+	frame   int
+	pressed map[string]bool
+	err     error
+}
+
+// Err returns the first error found while writing the session
+func (r *Recorder) Err() error {
+	return r.err
+}
+
+// poll checks the state of every key and writes the ones that changed since the last frame
+func (r *Recorder) poll() {
+	for _, k := range Keys {
+		down := r.Engine.KeyPressed(k)
+		if down == r.pressed[k] {
+			continue
+		}
+
+		r.pressed[k] = down
+		state := "up"
+		if down {
+			state = "down"
+		}
+
+		if _, err := fmt.Fprintf(r.file, "frame %d: %s %s\n", r.frame, k, state); err != nil && r.err == nil {
+			r.err = err
+		}
+	}
+}
+
+// Update finishes the frame of the engine and records the keys of the next one
+func (r *Recorder) Update() {
+	r.Engine.Update()
+	r.frame++
+	r.poll()
+}
+
+// Close finishes the session file
+func (r *Recorder) Close() error {
+	err := r.file.Close()
+	if r.err != nil {
+		return r.err
+	}
+	return err
+}
+
+// NewRecorder creates an engine that records the keys of e to a new session file in path
+func NewRecorder(e Engine, path string) (*Recorder, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Recorder{e, file, 0, make(map[string]bool), nil}
+
+	if _, err := fmt.Fprintf(file, "// Input session, replay it with run -input %s\n", path); err != nil {
+		file.Close()
+		return nil, err
+	}
+	r.poll()
+
+	return r, nil
+}
@@ -0,0 +1,179 @@
+package engine
+
+import (
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// KeyEvent changes the state of a key at the start of a frame
+type KeyEvent struct {
+	Frame int
+	Key   string
+	Down  bool
+}
+
+// Script is an input source that replays a list of key events. Each entry of a script has the form
+// "frame 10: W down", entries are separated by new lines or commas and an entry without a frame
+// uses the frame of the previous one, so "frame 3: W down, A down" presses both keys
+type Script struct {
+	events  []KeyEvent
+	next    int
+	frame   int
+	pressed map[string]bool
+}
+
+// Events returns the key events of the script ordered by frame and key
+func (s *Script) Events() []KeyEvent {
+	return s.events
+}
+
+// Frame returns the frame the script is currently in
+func (s *Script) Frame() int {
+	return s.frame
+}
+
+// apply changes the state of the keys with every event up to the current frame
+func (s *Script) apply() {
+	for s.next < len(s.events) && s.events[s.next].Frame <= s.frame {
+		e := s.events[s.next]
+		s.pressed[e.Key] = e.Down
+		s.next++
+	}
+}
+
+// Advance moves the script to the next frame
+func (s *Script) Advance() {
+	s.frame++
+	s.apply()
+}
+
+// KeyPressed checks if the key is down in the current frame
+func (s *Script) KeyPressed(k string) bool {
+	return s.pressed[k]
+}
+
+// parseEntry parses an entry of the script, frame is the frame of the previous entry or -1 if there is none
+func parseEntry(entry string, frame int, line int) (KeyEvent, error) {
+	if strings.HasPrefix(entry, "frame") {
+		colon := strings.Index(entry, ":")
+		if colon == -1 {
+			return KeyEvent{}, errutil.NewNoPosf("Line %d: Expected ':' after the frame of %q", line, entry)
+		}
+
+		f, err := strconv.Atoi(strings.TrimSpace(entry[len("frame"):colon]))
+		if err != nil || f < 0 {
+			return KeyEvent{}, errutil.NewNoPosf("Line %d: Invalid frame in %q", line, entry)
+		}
+
+		frame = f
+		entry = strings.TrimSpace(entry[colon+1:])
+	}
+
+	if frame == -1 {
+		return KeyEvent{}, errutil.NewNoPosf("Line %d: Entry %q has no frame", line, entry)
+	}
+
+	fields := strings.Fields(entry)
+	if len(fields) != 2 {
+		return KeyEvent{}, errutil.NewNoPosf("Line %d: Expected a key and a state in %q", line, entry)
+	}
+
+	if !isKey(fields[0]) {
+		return KeyEvent{}, errutil.NewNoPosf("Line %d: Unknown key %s, expected one of: %s", line, fields[0], strings.Join(Keys, " "))
+	}
+
+	switch fields[1] {
+	case "down":
+		return KeyEvent{frame, fields[0], true}, nil
+	case "up":
+		return KeyEvent{frame, fields[0], false}, nil
+	}
+
+	return KeyEvent{}, errutil.NewNoPosf("Line %d: Key state must be down or up, got %s", line, fields[1])
+}
+
+// isKey checks if the name is one of the Keys
+func isKey(name string) bool {
+	for _, k := range Keys {
+		if k == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseScript parses the source of a script. Empty lines and lines starting with // are ignored
+func ParseScript(src string) (*Script, error) {
+	events := make([]KeyEvent, 0)
+	frame := -1
+
+	for i, line := range strings.Split(src, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		for _, entry := range strings.Split(line, ",") {
+			entry = strings.TrimSpace(entry)
+			if entry == "" {
+				continue
+			}
+
+			e, err := parseEntry(entry, frame, i+1)
+			if err != nil {
+				return nil, err
+			}
+
+			frame = e.Frame
+			events = append(events, e)
+		}
+	}
+
+	// The order of the keys inside of a frame does not matter, but the order of the events of the same key does
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].Frame != events[j].Frame {
+			return events[i].Frame < events[j].Frame
+		}
+		return events[i].Key < events[j].Key
+	})
+
+	s := &Script{events, 0, 0, make(map[string]bool)}
+	s.apply()
+
+	return s, nil
+}
+
+// LoadScript reads and parses the script in the file of path
+func LoadScript(path string) (*Script, error) {
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseScript(string(src))
+}
+
+// Playback is an engine that draws with another renderer, but reads the keys from a script
+// which advances on every Update
+type Playback struct {
+	Renderer
+	script *Script
+}
+
+func (p *Playback) KeyPressed(k string) bool {
+	return p.script.KeyPressed(k)
+}
+
+func (p *Playback) Update() {
+	p.Renderer.Update()
+	p.script.Advance()
+}
+
+// NewPlayback creates an engine that replays the script on top of the renderer
+func NewPlayback(r Renderer, s *Script) *Playback {
+	return &Playback{r, s}
+}
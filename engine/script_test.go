@@ -0,0 +1,45 @@
+package engine
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseScript(t *testing.T) {
+	s, err := ParseScript("// Both players move\nframe 0: W down, Up down\n\nframe 2: W up\n")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	expects := []KeyEvent{{0, "Up", true}, {0, "W", true}, {2, "W", false}}
+	if !reflect.DeepEqual(s.Events(), expects) {
+		t.Errorf("Expected events %v, got %v", expects, s.Events())
+	}
+
+	tests := []struct {
+		src     string
+		expects string
+	}{
+		{"frame 0: W down\nframe x: W up\n", "Line 2: Invalid frame in \"frame x: W up\""},
+		{"frame -1: W down\n", "Line 1: Invalid frame in \"frame -1: W down\""},
+		{"frame 0 W down\n", "Line 1: Expected ':' after the frame of \"frame 0 W down\""},
+		{"W down\n", "Line 1: Entry \"W down\" has no frame"},
+		{"frame 0: W\n", "Line 1: Expected a key and a state in \"W\""},
+		{"frame 0: W down\nframe 1: W press\n", "Line 2: Key state must be down or up, got press"},
+		{"frame 0: Space down\n// Typo\nframe 3: Upp down\n", "Line 3: Unknown key Upp, expected one of:"},
+		{"frame 0: w down\n", "Line 1: Unknown key w, expected one of:"},
+	}
+
+	for _, test := range tests {
+		_, err := ParseScript(test.src)
+		if err == nil {
+			t.Errorf("%q: Expected error %q", test.src, test.expects)
+			continue
+		}
+
+		if !strings.Contains(err.Error(), test.expects) {
+			t.Errorf("%q: Expected error %q, got %q", test.src, test.expects, err.Error())
+		}
+	}
+}
@@ -0,0 +1,176 @@
+// Package window implements the engine with a pixelgl window
+package window
+
+// importar pixel
+import (
+	"image"
+	"os"
+	"fmt"
+
+	_ "image/png"
+
+	"github.com/sdkvictor/golang-compiler/engine"
+	"github.com/sdkvictor/golang-compiler/objects"
+
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/pixelgl"
+	"github.com/faiface/pixel/imdraw"
+	"github.com/faiface/pixel/text"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/colornames"
+	"github.com/nfnt/resize"
+)
+
+/*
+const (
+	windowWidth  = 1024
+	windowHeight = 768
+)
+*/
+
+type Engine struct {
+	win *pixelgl.Window
+	imd *imdraw.IMDraw
+
+}
+
+func toRGB(h string) (engine.RGB, error) {
+	return engine.Hex2RGB(h)
+}
+
+
+func loadPicture(path string, i objects.Image) (pixel.Picture, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, err
+	}
+	newImage := resize.Resize(uint(i.Width()), uint(i.Height()), img, resize.Lanczos3)
+	return pixel.PictureDataFromImage(newImage), nil
+}
+
+func (e *Engine) DrawSquare(s objects.Square) {
+	c, _:= toRGB(s.Color())
+	e.imd.Color = pixel.RGB(float64(c.Red)/255, float64(c.Green)/255, float64(c.Blue)/255)
+	r := pixel.R(s.X(), s.Y(), s.X()+s.Width(), s.Y()+s.Height())
+	e.imd.Push(r.Min, r.Max)
+	e.imd.Rectangle(0)
+	e.imd.Draw(e.win)
+}
+
+func (e *Engine) DrawCircle(c objects.Circle) {
+	r,_ := toRGB(c.Color())
+	e.imd.Color = pixel.RGB(float64(r.Red)/255, float64(r.Green)/255, float64(r.Blue)/255)
+	e.imd.Push(pixel.V(c.X(), c.Y()))
+	e.imd.Ellipse(pixel.V(c.Width()/2, c.Height()/2), 0)
+	e.imd.Draw(e.win)
+}
+
+func (e *Engine) DrawText(t objects.Text) {
+	basicAtlas := text.NewAtlas(basicfont.Face7x13, text.ASCII)
+	basicTxt := text.New(pixel.V(t.X(), t.Y()), basicAtlas)
+
+	fmt.Fprintln(basicTxt, t.Message())
+	basicTxt.Draw(e.win, pixel.IM)
+}
+
+func (e *Engine) DrawImage(i objects.Image) {
+	pic, err := loadPicture(i.Image(), i)
+	if err != nil {
+		panic(err)
+	}
+
+	sprite := pixel.NewSprite(pic, pic.Bounds())
+	sprite.Draw(e.win, pixel.IM.Moved(pixel.V(i.X(), i.Y())))
+}
+
+func (e *Engine) WindowClosed() bool {
+	return e.win.Closed()
+}
+
+
+// keys maps the names of the keys to the buttons of pixelgl, it must have every key of engine.Keys
+var keys = map[string]pixelgl.Button{
+	"Space":     pixelgl.KeySpace,
+	"Up":        pixelgl.KeyUp,
+	"Down":      pixelgl.KeyDown,
+	"Right":     pixelgl.KeyRight,
+	"Left":      pixelgl.KeyLeft,
+	"W":         pixelgl.KeyW,
+	"A":         pixelgl.KeyA,
+	"S":         pixelgl.KeyS,
+	"D":         pixelgl.KeyD,
+	"Q":         pixelgl.KeyQ,
+	"E":         pixelgl.KeyE,
+	"R":         pixelgl.KeyR,
+	"T":         pixelgl.KeyT,
+	"Y":         pixelgl.KeyY,
+	"U":         pixelgl.KeyU,
+	"I":         pixelgl.KeyI,
+	"O":         pixelgl.KeyO,
+	"P":         pixelgl.KeyP,
+	"F":         pixelgl.KeyF,
+	"G":         pixelgl.KeyG,
+	"H":         pixelgl.KeyH,
+	"J":         pixelgl.KeyJ,
+	"K":         pixelgl.KeyK,
+	"L":         pixelgl.KeyL,
+	"Z":         pixelgl.KeyZ,
+	"X":         pixelgl.KeyX,
+	"C":         pixelgl.KeyC,
+	"V":         pixelgl.KeyV,
+	"B":         pixelgl.KeyB,
+	"N":         pixelgl.KeyN,
+	"M":         pixelgl.KeyM,
+	"Enter":     pixelgl.KeyEnter,
+	"Backspace": pixelgl.KeyBackspace,
+	"Esc":       pixelgl.KeyEscape,
+	"MouseLeft": pixelgl.MouseButtonLeft,
+}
+
+func (e *Engine) KeyPressed(k string) bool {
+	button, ok := keys[k]
+	if !ok {
+		return false
+	}
+
+	// Clicks are only reported in the frame they happen
+	if k == "MouseLeft" {
+		return e.win.JustPressed(button)
+	}
+	return e.win.Pressed(button)
+}
+
+func (e *Engine) Clear() {
+	e.win.Clear(colornames.Black)
+	e.imd.Clear()
+	//e.imd.Reset()
+}
+
+func (e *Engine) Update() {
+	e.win.Update()
+}
+
+func NewEngine(name string, windowWidth float64, windowHeight float64) *Engine {
+	cfg := pixelgl.WindowConfig{
+        Title:  name,
+        Bounds: pixel.R(0, 0, windowWidth, windowHeight),
+        VSync:  true,
+    }
+    win, err := pixelgl.NewWindow(cfg)
+    if err != nil {
+        panic(err)
+    }
+
+	win.Clear(colornames.Black)
+
+	return &Engine{
+		win,
+		imdraw.New(nil),
+	}
+}
\ No newline at end of file
@@ -29,7 +29,7 @@ var ActTab = ActionTable{
 		Ignore: "!ws",
 	},
 	ActionRow{ // S2
-		Accept: 0,
+		Accept: 38,
 		Ignore: "",
 	},
 	ActionRow{ // S3
@@ -37,71 +37,71 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S4
-		Accept: 0,
+		Accept: 37,
 		Ignore: "",
 	},
 	ActionRow{ // S5
-		Accept: 9,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S6
-		Accept: 10,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S7
-		Accept: 26,
+		Accept: 11,
 		Ignore: "",
 	},
 	ActionRow{ // S8
-		Accept: 24,
+		Accept: 12,
 		Ignore: "",
 	},
 	ActionRow{ // S9
-		Accept: 8,
+		Accept: 35,
 		Ignore: "",
 	},
 	ActionRow{ // S10
-		Accept: 25,
+		Accept: 33,
 		Ignore: "",
 	},
 	ActionRow{ // S11
-		Accept: 42,
+		Accept: 16,
 		Ignore: "",
 	},
 	ActionRow{ // S12
-		Accept: 27,
+		Accept: 34,
 		Ignore: "",
 	},
 	ActionRow{ // S13
-		Accept: 35,
+		Accept: 50,
 		Ignore: "",
 	},
 	ActionRow{ // S14
-		Accept: 43,
+		Accept: 36,
 		Ignore: "",
 	},
 	ActionRow{ // S15
-		Accept: 4,
+		Accept: 51,
 		Ignore: "",
 	},
 	ActionRow{ // S16
-		Accept: 22,
+		Accept: 10,
 		Ignore: "",
 	},
 	ActionRow{ // S17
-		Accept: 28,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S18
-		Accept: 22,
+		Accept: 32,
 		Ignore: "",
 	},
 	ActionRow{ // S19
-		Accept: 3,
+		Accept: 14,
 		Ignore: "",
 	},
 	ActionRow{ // S20
-		Accept: 3,
+		Accept: 32,
 		Ignore: "",
 	},
 	ActionRow{ // S21
@@ -121,19 +121,19 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S25
-		Accept: 40,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S26
-		Accept: 41,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S27
-		Accept: 3,
+		Accept: 56,
 		Ignore: "",
 	},
 	ActionRow{ // S28
-		Accept: 3,
+		Accept: 57,
 		Ignore: "",
 	},
 	ActionRow{ // S29
@@ -177,19 +177,19 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S39
-		Accept: 5,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S40
-		Accept: 0,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S41
-		Accept: 6,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S42
-		Accept: 37,
+		Accept: 5,
 		Ignore: "",
 	},
 	ActionRow{ // S43
@@ -197,11 +197,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S44
-		Accept: 0,
+		Accept: 6,
 		Ignore: "",
 	},
 	ActionRow{ // S45
-		Accept: 23,
+		Accept: 31,
 		Ignore: "",
 	},
 	ActionRow{ // S46
@@ -209,7 +209,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S47
-		Accept: 0,
+		Accept: 53,
 		Ignore: "",
 	},
 	ActionRow{ // S48
@@ -217,39 +217,39 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S49
-		Accept: 0,
+		Accept: 39,
 		Ignore: "",
 	},
 	ActionRow{ // S50
-		Accept: 0,
+		Accept: 30,
 		Ignore: "",
 	},
 	ActionRow{ // S51
-		Accept: 22,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S52
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S53
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S54
-		Accept: 3,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S55
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S56
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S57
-		Accept: 3,
+		Accept: 32,
 		Ignore: "",
 	},
 	ActionRow{ // S58
@@ -277,7 +277,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S64
-		Accept: 30,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S65
@@ -313,15 +313,15 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S73
-		Accept: 38,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S74
-		Accept: -1,
-		Ignore: "!comment",
+		Accept: 3,
+		Ignore: "",
 	},
 	ActionRow{ // S75
-		Accept: 36,
+		Accept: 42,
 		Ignore: "",
 	},
 	ActionRow{ // S76
@@ -361,27 +361,27 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S85
-		Accept: 3,
+		Accept: 29,
 		Ignore: "",
 	},
 	ActionRow{ // S86
-		Accept: 33,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S87
-		Accept: 12,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S88
-		Accept: 3,
+		Accept: 54,
 		Ignore: "",
 	},
 	ActionRow{ // S89
-		Accept: 3,
-		Ignore: "",
+		Accept: -1,
+		Ignore: "!comment",
 	},
 	ActionRow{ // S90
-		Accept: 3,
+		Accept: 52,
 		Ignore: "",
 	},
 	ActionRow{ // S91
@@ -421,19 +421,19 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S100
-		Accept: 20,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S101
-		Accept: 14,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S102
-		Accept: 16,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S103
-		Accept: 31,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S104
@@ -445,11 +445,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S106
-		Accept: 44,
+		Accept: 48,
 		Ignore: "",
 	},
 	ActionRow{ // S107
-		Accept: 3,
+		Accept: 19,
 		Ignore: "",
 	},
 	ActionRow{ // S108
@@ -465,11 +465,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S111
-		Accept: 39,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S112
-		Accept: 11,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S113
@@ -485,23 +485,23 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S116
-		Accept: 19,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S117
-		Accept: 3,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S118
-		Accept: 39,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S119
-		Accept: 13,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S120
-		Accept: 29,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S121
@@ -509,27 +509,27 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S122
-		Accept: 3,
+		Accept: 27,
 		Ignore: "",
 	},
 	ActionRow{ // S123
-		Accept: 3,
+		Accept: 21,
 		Ignore: "",
 	},
 	ActionRow{ // S124
-		Accept: 34,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S125
-		Accept: 3,
+		Accept: 45,
 		Ignore: "",
 	},
 	ActionRow{ // S126
-		Accept: 18,
+		Accept: 23,
 		Ignore: "",
 	},
 	ActionRow{ // S127
-		Accept: 17,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S128
@@ -537,19 +537,19 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S129
-		Accept: 32,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S130
-		Accept: 15,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S131
-		Accept: 3,
+		Accept: 43,
 		Ignore: "",
 	},
 	ActionRow{ // S132
-		Accept: 2,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S133
@@ -557,11 +557,195 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S134
-		Accept: 3,
+		Accept: 58,
 		Ignore: "",
 	},
 	ActionRow{ // S135
-		Accept: 21,
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S136
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S137
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S138
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S139
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S140
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S141
+		Accept: 55,
+		Ignore: "",
+	},
+	ActionRow{ // S142
+		Accept: 13,
+		Ignore: "",
+	},
+	ActionRow{ // S143
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S144
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S145
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S146
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S147
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S148
+		Accept: 26,
+		Ignore: "",
+	},
+	ActionRow{ // S149
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S150
+		Accept: 17,
+		Ignore: "",
+	},
+	ActionRow{ // S151
+		Accept: 9,
+		Ignore: "",
+	},
+	ActionRow{ // S152
+		Accept: 15,
+		Ignore: "",
+	},
+	ActionRow{ // S153
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S154
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S155
+		Accept: 55,
+		Ignore: "",
+	},
+	ActionRow{ // S156
+		Accept: 20,
+		Ignore: "",
+	},
+	ActionRow{ // S157
+		Accept: 41,
+		Ignore: "",
+	},
+	ActionRow{ // S158
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S159
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S160
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S161
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S162
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S163
+		Accept: 49,
+		Ignore: "",
+	},
+	ActionRow{ // S164
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S165
+		Accept: 25,
+		Ignore: "",
+	},
+	ActionRow{ // S166
+		Accept: 24,
+		Ignore: "",
+	},
+	ActionRow{ // S167
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S168
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S169
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S170
+		Accept: 47,
+		Ignore: "",
+	},
+	ActionRow{ // S171
+		Accept: 22,
+		Ignore: "",
+	},
+	ActionRow{ // S172
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S173
+		Accept: 44,
+		Ignore: "",
+	},
+	ActionRow{ // S174
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S175
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S176
+		Accept: 46,
+		Ignore: "",
+	},
+	ActionRow{ // S177
+		Accept: 2,
+		Ignore: "",
+	},
+	ActionRow{ // S178
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S179
+		Accept: 18,
+		Ignore: "",
+	},
+	ActionRow{ // S180
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S181
+		Accept: 28,
 		Ignore: "",
 	},
 }
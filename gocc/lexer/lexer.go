@@ -11,8 +11,8 @@ import (
 
 const (
 	NoState    = -1
-	NumStates  = 136
-	NumSymbols = 155
+	NumStates  = 182
+	NumSymbols = 227
 )
 
 type Lexer struct {
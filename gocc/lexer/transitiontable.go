@@ -21,108 +21,112 @@ var TransTab = TransitionTable{
 			return 1
 		case r == 32: // [' ',' ']
 			return 1
-		case r == 34: // ['"','"']
+		case r == 33: // ['!','!']
 			return 2
-		case r == 38: // ['&','&']
+		case r == 34: // ['"','"']
 			return 3
-		case r == 39: // [''',''']
+		case r == 37: // ['%','%']
 			return 4
-		case r == 40: // ['(','(']
+		case r == 38: // ['&','&']
 			return 5
-		case r == 41: // [')',')']
+		case r == 39: // [''',''']
 			return 6
-		case r == 42: // ['*','*']
+		case r == 40: // ['(','(']
 			return 7
-		case r == 43: // ['+','+']
+		case r == 41: // [')',')']
 			return 8
-		case r == 44: // [',',',']
+		case r == 42: // ['*','*']
 			return 9
-		case r == 45: // ['-','-']
+		case r == 43: // ['+','+']
 			return 10
-		case r == 46: // ['.','.']
+		case r == 44: // [',',',']
 			return 11
-		case r == 47: // ['/','/']
+		case r == 45: // ['-','-']
 			return 12
-		case 48 <= r && r <= 57: // ['0','9']
+		case r == 46: // ['.','.']
 			return 13
-		case r == 58: // [':',':']
+		case r == 47: // ['/','/']
 			return 14
-		case r == 59: // [';',';']
+		case 48 <= r && r <= 57: // ['0','9']
 			return 15
-		case r == 60: // ['<','<']
+		case r == 58: // [':',':']
 			return 16
-		case r == 61: // ['=','=']
+		case r == 59: // [';',';']
 			return 17
-		case r == 62: // ['>','>']
+		case r == 60: // ['<','<']
 			return 18
-		case r == 65: // ['A','A']
+		case r == 61: // ['=','=']
 			return 19
-		case r == 66: // ['B','B']
+		case r == 62: // ['>','>']
 			return 20
-		case r == 67: // ['C','C']
+		case r == 65: // ['A','A']
 			return 21
+		case r == 66: // ['B','B']
+			return 22
+		case r == 67: // ['C','C']
+			return 23
 		case 68 <= r && r <= 72: // ['D','H']
-			return 19
+			return 21
 		case r == 73: // ['I','I']
-			return 22
+			return 24
 		case 74 <= r && r <= 82: // ['J','R']
-			return 19
+			return 21
 		case r == 83: // ['S','S']
-			return 23
+			return 25
 		case r == 84: // ['T','T']
-			return 24
+			return 26
 		case 85 <= r && r <= 90: // ['U','Z']
-			return 19
+			return 21
 		case r == 91: // ['[','[']
-			return 25
+			return 27
 		case r == 93: // [']',']']
-			return 26
+			return 28
 		case r == 97: // ['a','a']
-			return 19
+			return 21
 		case r == 98: // ['b','b']
-			return 27
+			return 29
 		case r == 99: // ['c','c']
-			return 28
+			return 30
 		case r == 100: // ['d','d']
-			return 19
+			return 31
 		case r == 101: // ['e','e']
-			return 29
+			return 32
 		case r == 102: // ['f','f']
-			return 30
+			return 33
 		case 103 <= r && r <= 104: // ['g','h']
-			return 19
+			return 21
 		case r == 105: // ['i','i']
-			return 31
+			return 34
 		case 106 <= r && r <= 107: // ['j','k']
-			return 19
+			return 21
 		case r == 108: // ['l','l']
-			return 32
+			return 35
 		case 109 <= r && r <= 111: // ['m','o']
-			return 19
+			return 21
 		case r == 112: // ['p','p']
-			return 33
+			return 36
 		case r == 113: // ['q','q']
-			return 19
+			return 21
 		case r == 114: // ['r','r']
-			return 34
+			return 37
 		case r == 115: // ['s','s']
-			return 35
+			return 38
 		case r == 116: // ['t','t']
-			return 36
+			return 39
 		case r == 117: // ['u','u']
-			return 19
+			return 21
 		case r == 118: // ['v','v']
-			return 37
+			return 40
 		case r == 119: // ['w','w']
-			return 38
+			return 41
 		case 120 <= r && r <= 122: // ['x','z']
-			return 19
+			return 21
 		case r == 123: // ['{','{']
-			return 39
+			return 42
 		case r == 124: // ['|','|']
-			return 40
+			return 43
 		case r == 125: // ['}','}']
-			return 41
+			return 44
 		}
 		return NoState
 	},
@@ -135,58 +139,58 @@ var TransTab = TransitionTable{
 	// S2
 	func(r rune) int {
 		switch {
-		case r == 32: // [' ',' ']
-			return 2
-		case r == 33: // ['!','!']
-			return 2
-		case r == 34: // ['"','"']
-			return 42
-		case r == 35: // ['#','#']
-			return 2
-		case r == 46: // ['.','.']
-			return 2
-		case 48 <= r && r <= 57: // ['0','9']
-			return 43
-		case r == 63: // ['?','?']
-			return 2
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 44
-		case 97 <= r && r <= 122: // ['a','z']
-			return 44
+		case r == 61: // ['=','=']
+			return 45
 		}
 		return NoState
 	},
 	// S3
 	func(r rune) int {
 		switch {
-		case r == 38: // ['&','&']
-			return 45
+		case 0 <= r && r <= 9: // [\u0000,'\t']
+			return 46
+		case 11 <= r && r <= 12: // ['\v','\f']
+			return 46
+		case 14 <= r && r <= 33: // [\u000e,'!']
+			return 46
+		case r == 34: // ['"','"']
+			return 47
+		case 35 <= r && r <= 91: // ['#','[']
+			return 46
+		case r == 92: // ['\','\']
+			return 48
+		case 93 <= r && r <= 1114111: // [']',\U0010ffff]
+			return 46
 		}
 		return NoState
 	},
 	// S4
 	func(r rune) int {
 		switch {
-		case r == 32: // [' ',' ']
-			return 46
-		case 48 <= r && r <= 57: // ['0','9']
-			return 47
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 48
-		case 97 <= r && r <= 122: // ['a','z']
-			return 48
+		case r == 61: // ['=','=']
+			return 49
 		}
 		return NoState
 	},
 	// S5
 	func(r rune) int {
 		switch {
+		case r == 38: // ['&','&']
+			return 50
 		}
 		return NoState
 	},
 	// S6
 	func(r rune) int {
 		switch {
+		case r == 32: // [' ',' ']
+			return 51
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 53
+		case 97 <= r && r <= 122: // ['a','z']
+			return 53
 		}
 		return NoState
 	},
@@ -205,14 +209,18 @@ var TransTab = TransitionTable{
 	// S9
 	func(r rune) int {
 		switch {
+		case r == 61: // ['=','=']
+			return 49
 		}
 		return NoState
 	},
 	// S10
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 13
+		case r == 43: // ['+','+']
+			return 54
+		case r == 61: // ['=','=']
+			return 49
 		}
 		return NoState
 	},
@@ -225,7 +233,9 @@ var TransTab = TransitionTable{
 	// S12
 	func(r rune) int {
 		switch {
-		case r == 47: // ['/','/']
+		case r == 45: // ['-','-']
+			return 54
+		case r == 61: // ['=','=']
 			return 49
 		}
 		return NoState
@@ -233,40 +243,38 @@ var TransTab = TransitionTable{
 	// S13
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 50
-		case 48 <= r && r <= 57: // ['0','9']
-			return 13
 		}
 		return NoState
 	},
 	// S14
 	func(r rune) int {
 		switch {
+		case r == 47: // ['/','/']
+			return 55
+		case r == 61: // ['=','=']
+			return 49
 		}
 		return NoState
 	},
 	// S15
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 56
+		case 48 <= r && r <= 57: // ['0','9']
+			return 15
 		}
 		return NoState
 	},
 	// S16
 	func(r rune) int {
 		switch {
-		case r == 61: // ['=','=']
-			return 51
-		case r == 62: // ['>','>']
-			return 51
 		}
 		return NoState
 	},
 	// S17
 	func(r rune) int {
 		switch {
-		case r == 61: // ['=','=']
-			return 51
 		}
 		return NoState
 	},
@@ -274,1660 +282,2304 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 51
+			return 57
+		case r == 62: // ['>','>']
+			return 45
 		}
 		return NoState
 	},
 	// S19
+	func(r rune) int {
+		switch {
+		case r == 61: // ['=','=']
+			return 45
+		}
+		return NoState
+	},
+	// S20
+	func(r rune) int {
+		switch {
+		case r == 61: // ['=','=']
+			return 57
+		}
+		return NoState
+	},
+	// S21
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S20
+	// S22
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case r == 97: // ['a','a']
-			return 53
+			return 59
 		case 98 <= r && r <= 122: // ['b','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S21
+	// S23
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 104: // ['a','h']
-			return 19
+			return 21
 		case r == 105: // ['i','i']
-			return 54
+			return 60
 		case 106 <= r && r <= 122: // ['j','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S22
+	// S24
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 108: // ['a','l']
-			return 19
+			return 21
 		case r == 109: // ['m','m']
-			return 55
+			return 61
 		case 110 <= r && r <= 122: // ['n','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S23
+	// S25
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 112: // ['a','p']
-			return 19
+			return 21
 		case r == 113: // ['q','q']
-			return 56
+			return 62
 		case 114 <= r && r <= 122: // ['r','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S24
+	// S26
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 100: // ['a','d']
-			return 19
+			return 21
 		case r == 101: // ['e','e']
-			return 57
+			return 63
 		case 102 <= r && r <= 122: // ['f','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S25
+	// S27
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S26
+	// S28
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S27
+	// S29
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 110: // ['a','n']
-			return 19
+			return 21
 		case r == 111: // ['o','o']
+			return 64
+		case 112 <= r && r <= 113: // ['p','q']
+			return 21
+		case r == 114: // ['r','r']
+			return 65
+		case 115 <= r && r <= 122: // ['s','z']
+			return 21
+		}
+		return NoState
+	},
+	// S30
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 97: // ['a','a']
+			return 66
+		case 98 <= r && r <= 103: // ['b','g']
+			return 21
+		case r == 104: // ['h','h']
+			return 67
+		case 105 <= r && r <= 107: // ['i','k']
+			return 21
+		case r == 108: // ['l','l']
+			return 68
+		case 109 <= r && r <= 110: // ['m','n']
+			return 21
+		case r == 111: // ['o','o']
+			return 69
 		case 112 <= r && r <= 122: // ['p','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S28
+	// S31
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 103: // ['a','g']
-			return 19
-		case r == 104: // ['h','h']
-			return 59
-		case 105 <= r && r <= 122: // ['i','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 100: // ['a','d']
+			return 21
+		case r == 101: // ['e','e']
+			return 70
+		case 102 <= r && r <= 122: // ['f','z']
+			return 21
 		}
 		return NoState
 	},
-	// S29
+	// S32
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 107: // ['a','k']
-			return 19
+			return 21
 		case r == 108: // ['l','l']
-			return 60
+			return 71
 		case 109 <= r && r <= 122: // ['m','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S30
+	// S33
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case r == 97: // ['a','a']
-			return 61
+			return 72
 		case 98 <= r && r <= 107: // ['b','k']
-			return 19
+			return 21
 		case r == 108: // ['l','l']
-			return 62
+			return 73
 		case 109 <= r && r <= 110: // ['m','n']
-			return 19
+			return 21
 		case r == 111: // ['o','o']
-			return 63
+			return 74
 		case 112 <= r && r <= 122: // ['p','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S31
+	// S34
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 101: // ['a','e']
-			return 19
+			return 21
 		case r == 102: // ['f','f']
-			return 64
+			return 75
 		case 103 <= r && r <= 109: // ['g','m']
-			return 19
+			return 21
 		case r == 110: // ['n','n']
-			return 65
+			return 76
 		case 111 <= r && r <= 122: // ['o','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S32
+	// S35
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 104: // ['a','h']
-			return 19
+			return 21
 		case r == 105: // ['i','i']
-			return 66
+			return 77
 		case 106 <= r && r <= 122: // ['j','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S33
+	// S36
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 113: // ['a','q']
-			return 19
+			return 21
 		case r == 114: // ['r','r']
-			return 67
+			return 78
 		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S34
+	// S37
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 100: // ['a','d']
-			return 19
+			return 21
 		case r == 101: // ['e','e']
-			return 68
+			return 79
 		case 102 <= r && r <= 122: // ['f','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S35
+	// S38
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 115: // ['a','s']
-			return 19
+			return 21
 		case r == 116: // ['t','t']
-			return 69
-		case 117 <= r && r <= 122: // ['u','z']
-			return 19
+			return 80
+		case 117 <= r && r <= 118: // ['u','v']
+			return 21
+		case r == 119: // ['w','w']
+			return 81
+		case 120 <= r && r <= 122: // ['x','z']
+			return 21
 		}
 		return NoState
 	},
-	// S36
+	// S39
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 113: // ['a','q']
-			return 19
+			return 21
 		case r == 114: // ['r','r']
-			return 70
+			return 82
 		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S37
+	// S40
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 110: // ['a','n']
-			return 19
+			return 21
 		case r == 111: // ['o','o']
-			return 71
+			return 83
 		case 112 <= r && r <= 122: // ['p','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S38
+	// S41
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 103: // ['a','g']
-			return 19
+			return 21
 		case r == 104: // ['h','h']
-			return 72
+			return 84
 		case 105 <= r && r <= 122: // ['i','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S39
+	// S42
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S40
+	// S43
 	func(r rune) int {
 		switch {
 		case r == 124: // ['|','|']
-			return 45
+			return 85
 		}
 		return NoState
 	},
-	// S41
+	// S44
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S42
+	// S45
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S43
+	// S46
 	func(r rune) int {
 		switch {
-		case r == 32: // [' ',' ']
-			return 2
-		case r == 33: // ['!','!']
-			return 2
+		case 0 <= r && r <= 9: // [\u0000,'\t']
+			return 46
+		case 11 <= r && r <= 12: // ['\v','\f']
+			return 46
+		case 14 <= r && r <= 33: // [\u000e,'!']
+			return 46
 		case r == 34: // ['"','"']
-			return 42
-		case r == 35: // ['#','#']
-			return 2
-		case r == 46: // ['.','.']
-			return 2
-		case 48 <= r && r <= 57: // ['0','9']
-			return 43
-		case r == 63: // ['?','?']
-			return 2
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 44
-		case 97 <= r && r <= 122: // ['a','z']
-			return 44
+			return 47
+		case 35 <= r && r <= 91: // ['#','[']
+			return 46
+		case r == 92: // ['\','\']
+			return 48
+		case 93 <= r && r <= 1114111: // [']',\U0010ffff]
+			return 46
 		}
 		return NoState
 	},
-	// S44
+	// S47
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S48
 	func(r rune) int {
 		switch {
-		case r == 32: // [' ',' ']
-			return 2
-		case r == 33: // ['!','!']
-			return 2
 		case r == 34: // ['"','"']
-			return 42
-		case r == 35: // ['#','#']
-			return 2
-		case r == 46: // ['.','.']
-			return 2
-		case 48 <= r && r <= 57: // ['0','9']
-			return 43
-		case r == 63: // ['?','?']
-			return 2
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 44
-		case 97 <= r && r <= 122: // ['a','z']
-			return 44
+			return 86
+		case r == 92: // ['\','\']
+			return 86
+		case r == 110: // ['n','n']
+			return 86
+		case r == 116: // ['t','t']
+			return 86
+		case r == 117: // ['u','u']
+			return 87
 		}
 		return NoState
 	},
-	// S45
+	// S49
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S46
+	// S50
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S51
 	func(r rune) int {
 		switch {
 		case r == 39: // [''',''']
-			return 73
+			return 88
 		}
 		return NoState
 	},
-	// S47
+	// S52
 	func(r rune) int {
 		switch {
 		case r == 39: // [''',''']
-			return 73
+			return 88
 		}
 		return NoState
 	},
-	// S48
+	// S53
 	func(r rune) int {
 		switch {
 		case r == 39: // [''',''']
-			return 73
+			return 88
 		}
 		return NoState
 	},
-	// S49
+	// S54
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S55
 	func(r rune) int {
 		switch {
 		case r == 10: // ['\n','\n']
-			return 74
+			return 89
 		default:
-			return 49
+			return 55
 		}
 	},
-	// S50
+	// S56
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 75
+			return 90
 		}
 		return NoState
 	},
-	// S51
+	// S57
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S52
+	// S58
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S53
+	// S59
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 98: // ['a','b']
-			return 19
+			return 21
 		case r == 99: // ['c','c']
-			return 76
+			return 91
 		case 100 <= r && r <= 122: // ['d','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S54
+	// S60
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 113: // ['a','q']
-			return 19
+			return 21
 		case r == 114: // ['r','r']
-			return 77
+			return 92
 		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S55
+	// S61
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case r == 97: // ['a','a']
-			return 78
+			return 93
 		case 98 <= r && r <= 122: // ['b','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S56
+	// S62
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 116: // ['a','t']
-			return 19
+			return 21
 		case r == 117: // ['u','u']
-			return 79
+			return 94
 		case 118 <= r && r <= 122: // ['v','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S57
+	// S63
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 119: // ['a','w']
-			return 19
+			return 21
 		case r == 120: // ['x','x']
-			return 80
+			return 95
 		case 121 <= r && r <= 122: // ['y','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S58
+	// S64
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 110: // ['a','n']
-			return 19
+			return 21
 		case r == 111: // ['o','o']
-			return 81
+			return 96
 		case 112 <= r && r <= 122: // ['p','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S59
+	// S65
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case r == 97: // ['a','a']
-			return 82
-		case 98 <= r && r <= 122: // ['b','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 100: // ['a','d']
+			return 21
+		case r == 101: // ['e','e']
+			return 97
+		case 102 <= r && r <= 122: // ['f','z']
+			return 21
 		}
 		return NoState
 	},
-	// S60
+	// S66
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 114: // ['a','r']
-			return 19
+			return 21
 		case r == 115: // ['s','s']
-			return 83
+			return 98
 		case 116 <= r && r <= 122: // ['t','z']
-			return 19
-		}
-		return NoState
-	},
-	// S61
-	func(r rune) int {
-		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 52
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 107: // ['a','k']
-			return 19
-		case r == 108: // ['l','l']
-			return 84
-		case 109 <= r && r <= 122: // ['m','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S62
+	// S67
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 110: // ['a','n']
-			return 19
-		case r == 111: // ['o','o']
-			return 85
-		case 112 <= r && r <= 122: // ['p','z']
-			return 19
+			return 21
+		case r == 97: // ['a','a']
+			return 99
+		case 98 <= r && r <= 122: // ['b','z']
+			return 21
 		}
 		return NoState
 	},
-	// S63
+	// S68
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 113: // ['a','q']
-			return 19
-		case r == 114: // ['r','r']
-			return 86
-		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 21
+		case r == 97: // ['a','a']
+			return 100
+		case 98 <= r && r <= 122: // ['b','z']
+			return 21
 		}
 		return NoState
 	},
-	// S64
+	// S69
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 109: // ['a','m']
+			return 21
+		case r == 110: // ['n','n']
+			return 101
+		case 111 <= r && r <= 122: // ['o','z']
+			return 21
 		}
 		return NoState
 	},
-	// S65
+	// S70
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 115: // ['a','s']
-			return 19
-		case r == 116: // ['t','t']
-			return 87
-		case 117 <= r && r <= 122: // ['u','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 101: // ['a','e']
+			return 21
+		case r == 102: // ['f','f']
+			return 102
+		case 103 <= r && r <= 122: // ['g','z']
+			return 21
 		}
 		return NoState
 	},
-	// S66
+	// S71
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 114: // ['a','r']
-			return 19
+			return 21
 		case r == 115: // ['s','s']
-			return 88
+			return 103
 		case 116 <= r && r <= 122: // ['t','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S67
+	// S72
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 104: // ['a','h']
-			return 19
+			return 21
+		case 97 <= r && r <= 107: // ['a','k']
+			return 21
+		case r == 108: // ['l','l']
+			return 104
+		case 109 <= r && r <= 122: // ['m','z']
+			return 21
+		}
+		return NoState
+	},
+	// S73
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 110: // ['a','n']
+			return 21
+		case r == 111: // ['o','o']
+			return 105
+		case 112 <= r && r <= 122: // ['p','z']
+			return 21
+		}
+		return NoState
+	},
+	// S74
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 113: // ['a','q']
+			return 21
+		case r == 114: // ['r','r']
+			return 106
+		case 115 <= r && r <= 122: // ['s','z']
+			return 21
+		}
+		return NoState
+	},
+	// S75
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
+		}
+		return NoState
+	},
+	// S76
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 115: // ['a','s']
+			return 21
+		case r == 116: // ['t','t']
+			return 107
+		case 117 <= r && r <= 122: // ['u','z']
+			return 21
+		}
+		return NoState
+	},
+	// S77
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 114: // ['a','r']
+			return 21
+		case r == 115: // ['s','s']
+			return 108
+		case 116 <= r && r <= 122: // ['t','z']
+			return 21
+		}
+		return NoState
+	},
+	// S78
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 104: // ['a','h']
+			return 21
 		case r == 105: // ['i','i']
-			return 89
+			return 109
 		case 106 <= r && r <= 110: // ['j','n']
-			return 19
+			return 21
 		case r == 111: // ['o','o']
-			return 90
+			return 110
 		case 112 <= r && r <= 122: // ['p','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S68
+	// S79
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 115: // ['a','s']
-			return 19
+			return 21
 		case r == 116: // ['t','t']
-			return 91
+			return 111
 		case 117 <= r && r <= 122: // ['u','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S69
+	// S80
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 113: // ['a','q']
-			return 19
+			return 21
 		case r == 114: // ['r','r']
-			return 92
+			return 112
 		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S70
+	// S81
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
+		case 97 <= r && r <= 104: // ['a','h']
+			return 21
+		case r == 105: // ['i','i']
+			return 113
+		case 106 <= r && r <= 122: // ['j','z']
+			return 21
+		}
+		return NoState
+	},
+	// S82
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
 		case 97 <= r && r <= 116: // ['a','t']
-			return 19
+			return 21
 		case r == 117: // ['u','u']
-			return 93
+			return 114
 		case 118 <= r && r <= 122: // ['v','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S71
+	// S83
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 104: // ['a','h']
-			return 19
+			return 21
 		case r == 105: // ['i','i']
-			return 94
+			return 115
 		case 106 <= r && r <= 122: // ['j','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S72
+	// S84
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 104: // ['a','h']
-			return 19
+			return 21
 		case r == 105: // ['i','i']
-			return 95
+			return 116
 		case 106 <= r && r <= 122: // ['j','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S73
+	// S85
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S74
+	// S86
 	func(r rune) int {
 		switch {
+		case 0 <= r && r <= 9: // [\u0000,'\t']
+			return 46
+		case 11 <= r && r <= 12: // ['\v','\f']
+			return 46
+		case 14 <= r && r <= 33: // [\u000e,'!']
+			return 46
+		case r == 34: // ['"','"']
+			return 47
+		case 35 <= r && r <= 91: // ['#','[']
+			return 46
+		case r == 92: // ['\','\']
+			return 48
+		case 93 <= r && r <= 1114111: // [']',\U0010ffff]
+			return 46
 		}
 		return NoState
 	},
-	// S75
+	// S87
+	func(r rune) int {
+		switch {
+		case r == 123: // ['{','{']
+			return 117
+		}
+		return NoState
+	},
+	// S88
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S89
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S90
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 75
+			return 90
 		}
 		return NoState
 	},
-	// S76
+	// S91
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 106: // ['a','j']
+			return 21
+		case r == 107: // ['k','k']
+			return 118
+		case 108 <= r && r <= 122: // ['l','z']
+			return 21
+		}
+		return NoState
+	},
+	// S92
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 98: // ['a','b']
+			return 21
+		case r == 99: // ['c','c']
+			return 119
+		case 100 <= r && r <= 122: // ['d','z']
+			return 21
+		}
+		return NoState
+	},
+	// S93
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 102: // ['a','f']
+			return 21
+		case r == 103: // ['g','g']
+			return 120
+		case 104 <= r && r <= 122: // ['h','z']
+			return 21
+		}
+		return NoState
+	},
+	// S94
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 97: // ['a','a']
+			return 121
+		case 98 <= r && r <= 122: // ['b','z']
+			return 21
+		}
+		return NoState
+	},
+	// S95
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 115: // ['a','s']
+			return 21
+		case r == 116: // ['t','t']
+			return 122
+		case 117 <= r && r <= 122: // ['u','z']
+			return 21
+		}
+		return NoState
+	},
+	// S96
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 107: // ['a','k']
+			return 21
+		case r == 108: // ['l','l']
+			return 123
+		case 109 <= r && r <= 122: // ['m','z']
+			return 21
+		}
+		return NoState
+	},
+	// S97
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 97: // ['a','a']
+			return 124
+		case 98 <= r && r <= 122: // ['b','z']
+			return 21
+		}
+		return NoState
+	},
+	// S98
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 100: // ['a','d']
+			return 21
+		case r == 101: // ['e','e']
+			return 125
+		case 102 <= r && r <= 122: // ['f','z']
+			return 21
+		}
+		return NoState
+	},
+	// S99
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 113: // ['a','q']
+			return 21
+		case r == 114: // ['r','r']
+			return 126
+		case 115 <= r && r <= 122: // ['s','z']
+			return 21
+		}
+		return NoState
+	},
+	// S100
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 114: // ['a','r']
+			return 21
+		case r == 115: // ['s','s']
+			return 127
+		case 116 <= r && r <= 122: // ['t','z']
+			return 21
+		}
+		return NoState
+	},
+	// S101
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 114: // ['a','r']
+			return 21
+		case r == 115: // ['s','s']
+			return 128
+		case r == 116: // ['t','t']
+			return 129
+		case 117 <= r && r <= 122: // ['u','z']
+			return 21
+		}
+		return NoState
+	},
+	// S102
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 97: // ['a','a']
+			return 130
+		case 98 <= r && r <= 122: // ['b','z']
+			return 21
+		}
+		return NoState
+	},
+	// S103
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 100: // ['a','d']
+			return 21
+		case r == 101: // ['e','e']
+			return 131
+		case 102 <= r && r <= 122: // ['f','z']
+			return 21
+		}
+		return NoState
+	},
+	// S104
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 114: // ['a','r']
+			return 21
+		case r == 115: // ['s','s']
+			return 132
+		case 116 <= r && r <= 122: // ['t','z']
+			return 21
+		}
+		return NoState
+	},
+	// S105
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 97: // ['a','a']
+			return 133
+		case 98 <= r && r <= 122: // ['b','z']
+			return 21
+		}
+		return NoState
+	},
+	// S106
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
+		}
+		return NoState
+	},
+	// S107
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
+		}
+		return NoState
+	},
+	// S108
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 115: // ['a','s']
+			return 21
+		case r == 116: // ['t','t']
+			return 134
+		case 117 <= r && r <= 122: // ['u','z']
+			return 21
+		}
+		return NoState
+	},
+	// S109
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 109: // ['a','m']
+			return 21
+		case r == 110: // ['n','n']
+			return 135
+		case 111 <= r && r <= 122: // ['o','z']
+			return 21
+		}
+		return NoState
+	},
+	// S110
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 102: // ['a','f']
+			return 21
+		case r == 103: // ['g','g']
+			return 136
+		case 104 <= r && r <= 122: // ['h','z']
+			return 21
+		}
+		return NoState
+	},
+	// S111
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 116: // ['a','t']
+			return 21
+		case r == 117: // ['u','u']
+			return 137
+		case 118 <= r && r <= 122: // ['v','z']
+			return 21
+		}
+		return NoState
+	},
+	// S112
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 104: // ['a','h']
+			return 21
+		case r == 105: // ['i','i']
+			return 138
+		case 106 <= r && r <= 116: // ['j','t']
+			return 21
+		case r == 117: // ['u','u']
+			return 139
+		case 118 <= r && r <= 122: // ['v','z']
+			return 21
+		}
+		return NoState
+	},
+	// S113
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 115: // ['a','s']
+			return 21
+		case r == 116: // ['t','t']
+			return 140
+		case 117 <= r && r <= 122: // ['u','z']
+			return 21
+		}
+		return NoState
+	},
+	// S114
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 100: // ['a','d']
+			return 21
+		case r == 101: // ['e','e']
+			return 141
+		case 102 <= r && r <= 122: // ['f','z']
+			return 21
+		}
+		return NoState
+	},
+	// S115
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 99: // ['a','c']
+			return 21
+		case r == 100: // ['d','d']
+			return 142
+		case 101 <= r && r <= 122: // ['e','z']
+			return 21
+		}
+		return NoState
+	},
+	// S116
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 107: // ['a','k']
+			return 21
+		case r == 108: // ['l','l']
+			return 143
+		case 109 <= r && r <= 122: // ['m','z']
+			return 21
+		}
+		return NoState
+	},
+	// S117
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 144
+		case 65 <= r && r <= 70: // ['A','F']
+			return 145
+		case 97 <= r && r <= 102: // ['a','f']
+			return 145
+		}
+		return NoState
+	},
+	// S118
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 102: // ['a','f']
+			return 21
+		case r == 103: // ['g','g']
+			return 146
+		case 104 <= r && r <= 122: // ['h','z']
+			return 21
+		}
+		return NoState
+	},
+	// S119
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 107: // ['a','k']
+			return 21
+		case r == 108: // ['l','l']
+			return 147
+		case 109 <= r && r <= 122: // ['m','z']
+			return 21
+		}
+		return NoState
+	},
+	// S120
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 100: // ['a','d']
+			return 21
+		case r == 101: // ['e','e']
+			return 148
+		case 102 <= r && r <= 122: // ['f','z']
+			return 21
+		}
+		return NoState
+	},
+	// S121
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 106: // ['a','j']
-			return 19
-		case r == 107: // ['k','k']
-			return 96
-		case 108 <= r && r <= 122: // ['l','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 113: // ['a','q']
+			return 21
+		case r == 114: // ['r','r']
+			return 149
+		case 115 <= r && r <= 122: // ['s','z']
+			return 21
 		}
 		return NoState
 	},
-	// S77
+	// S122
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 98: // ['a','b']
-			return 19
-		case r == 99: // ['c','c']
-			return 97
-		case 100 <= r && r <= 122: // ['d','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
 		}
 		return NoState
 	},
-	// S78
+	// S123
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 102: // ['a','f']
-			return 19
-		case r == 103: // ['g','g']
-			return 98
-		case 104 <= r && r <= 122: // ['h','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
 		}
 		return NoState
 	},
-	// S79
+	// S124
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case r == 97: // ['a','a']
-			return 99
-		case 98 <= r && r <= 122: // ['b','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 106: // ['a','j']
+			return 21
+		case r == 107: // ['k','k']
+			return 150
+		case 108 <= r && r <= 122: // ['l','z']
+			return 21
 		}
 		return NoState
 	},
-	// S80
+	// S125
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 115: // ['a','s']
-			return 19
-		case r == 116: // ['t','t']
-			return 100
-		case 117 <= r && r <= 122: // ['u','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
 		}
 		return NoState
 	},
-	// S81
+	// S126
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 107: // ['a','k']
-			return 19
-		case r == 108: // ['l','l']
-			return 101
-		case 109 <= r && r <= 122: // ['m','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
 		}
 		return NoState
 	},
-	// S82
+	// S127
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 113: // ['a','q']
-			return 19
-		case r == 114: // ['r','r']
-			return 102
-		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 114: // ['a','r']
+			return 21
+		case r == 115: // ['s','s']
+			return 151
+		case 116 <= r && r <= 122: // ['t','z']
+			return 21
 		}
 		return NoState
 	},
-	// S83
+	// S128
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 100: // ['a','d']
-			return 19
-		case r == 101: // ['e','e']
-			return 103
-		case 102 <= r && r <= 122: // ['f','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 115: // ['a','s']
+			return 21
+		case r == 116: // ['t','t']
+			return 152
+		case 117 <= r && r <= 122: // ['u','z']
+			return 21
 		}
 		return NoState
 	},
-	// S84
+	// S129
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 114: // ['a','r']
-			return 19
-		case r == 115: // ['s','s']
-			return 104
-		case 116 <= r && r <= 122: // ['t','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 104: // ['a','h']
+			return 21
+		case r == 105: // ['i','i']
+			return 153
+		case 106 <= r && r <= 122: // ['j','z']
+			return 21
 		}
 		return NoState
 	},
-	// S85
+	// S130
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case r == 97: // ['a','a']
-			return 105
-		case 98 <= r && r <= 122: // ['b','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 116: // ['a','t']
+			return 21
+		case r == 117: // ['u','u']
+			return 154
+		case 118 <= r && r <= 122: // ['v','z']
+			return 21
 		}
 		return NoState
 	},
-	// S86
+	// S131
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S87
+	// S132
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 100: // ['a','d']
+			return 21
+		case r == 101: // ['e','e']
+			return 155
+		case 102 <= r && r <= 122: // ['f','z']
+			return 21
 		}
 		return NoState
 	},
-	// S88
+	// S133
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 115: // ['a','s']
-			return 19
+			return 21
 		case r == 116: // ['t','t']
-			return 106
+			return 156
 		case 117 <= r && r <= 122: // ['u','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S89
+	// S134
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 109: // ['a','m']
-			return 19
-		case r == 110: // ['n','n']
-			return 107
-		case 111 <= r && r <= 122: // ['o','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
 		}
 		return NoState
 	},
-	// S90
+	// S135
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 102: // ['a','f']
-			return 19
-		case r == 103: // ['g','g']
-			return 108
-		case 104 <= r && r <= 122: // ['h','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 115: // ['a','s']
+			return 21
+		case r == 116: // ['t','t']
+			return 157
+		case 117 <= r && r <= 122: // ['u','z']
+			return 21
 		}
 		return NoState
 	},
-	// S91
+	// S136
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 116: // ['a','t']
-			return 19
-		case r == 117: // ['u','u']
-			return 109
-		case 118 <= r && r <= 122: // ['v','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 113: // ['a','q']
+			return 21
+		case r == 114: // ['r','r']
+			return 158
+		case 115 <= r && r <= 122: // ['s','z']
+			return 21
 		}
 		return NoState
 	},
-	// S92
+	// S137
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 104: // ['a','h']
-			return 19
-		case r == 105: // ['i','i']
-			return 110
-		case 106 <= r && r <= 122: // ['j','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 113: // ['a','q']
+			return 21
+		case r == 114: // ['r','r']
+			return 159
+		case 115 <= r && r <= 122: // ['s','z']
+			return 21
 		}
 		return NoState
 	},
-	// S93
+	// S138
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 100: // ['a','d']
-			return 19
-		case r == 101: // ['e','e']
-			return 111
-		case 102 <= r && r <= 122: // ['f','z']
-			return 19
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 109: // ['a','m']
+			return 21
+		case r == 110: // ['n','n']
+			return 160
+		case 111 <= r && r <= 122: // ['o','z']
+			return 21
 		}
 		return NoState
 	},
-	// S94
+	// S139
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 99: // ['a','c']
-			return 19
-		case r == 100: // ['d','d']
-			return 112
-		case 101 <= r && r <= 122: // ['e','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 98: // ['a','b']
+			return 21
+		case r == 99: // ['c','c']
+			return 161
+		case 100 <= r && r <= 122: // ['d','z']
+			return 21
 		}
 		return NoState
 	},
-	// S95
+	// S140
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 107: // ['a','k']
-			return 19
-		case r == 108: // ['l','l']
-			return 113
-		case 109 <= r && r <= 122: // ['m','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 98: // ['a','b']
+			return 21
+		case r == 99: // ['c','c']
+			return 162
+		case 100 <= r && r <= 122: // ['d','z']
+			return 21
 		}
 		return NoState
 	},
-	// S96
+	// S141
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 102: // ['a','f']
-			return 19
-		case r == 103: // ['g','g']
-			return 114
-		case 104 <= r && r <= 122: // ['h','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
 		}
 		return NoState
 	},
-	// S97
+	// S142
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 107: // ['a','k']
-			return 19
-		case r == 108: // ['l','l']
-			return 115
-		case 109 <= r && r <= 122: // ['m','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
 		}
 		return NoState
 	},
-	// S98
+	// S143
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 100: // ['a','d']
-			return 19
+			return 21
 		case r == 101: // ['e','e']
-			return 116
+			return 163
 		case 102 <= r && r <= 122: // ['f','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S99
+	// S144
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 113: // ['a','q']
-			return 19
-		case r == 114: // ['r','r']
-			return 117
-		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 144
+		case 65 <= r && r <= 70: // ['A','F']
+			return 145
+		case 97 <= r && r <= 102: // ['a','f']
+			return 145
+		case r == 125: // ['}','}']
+			return 86
 		}
 		return NoState
 	},
-	// S100
+	// S145
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 144
+		case 65 <= r && r <= 70: // ['A','F']
+			return 145
+		case 97 <= r && r <= 102: // ['a','f']
+			return 145
+		case r == 125: // ['}','}']
+			return 86
 		}
 		return NoState
 	},
-	// S101
+	// S146
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 113: // ['a','q']
+			return 21
+		case r == 114: // ['r','r']
+			return 164
+		case 115 <= r && r <= 122: // ['s','z']
+			return 21
 		}
 		return NoState
 	},
-	// S102
+	// S147
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 100: // ['a','d']
+			return 21
+		case r == 101: // ['e','e']
+			return 165
+		case 102 <= r && r <= 122: // ['f','z']
+			return 21
 		}
 		return NoState
 	},
-	// S103
+	// S148
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S104
+	// S149
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 100: // ['a','d']
-			return 19
+			return 21
 		case r == 101: // ['e','e']
-			return 118
+			return 166
 		case 102 <= r && r <= 122: // ['f','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S105
+	// S150
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 115: // ['a','s']
-			return 19
-		case r == 116: // ['t','t']
-			return 119
-		case 117 <= r && r <= 122: // ['u','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
 		}
 		return NoState
 	},
-	// S106
+	// S151
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S107
+	// S152
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 115: // ['a','s']
-			return 19
-		case r == 116: // ['t','t']
-			return 120
-		case 117 <= r && r <= 122: // ['u','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
 		}
 		return NoState
 	},
-	// S108
+	// S153
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 113: // ['a','q']
-			return 19
-		case r == 114: // ['r','r']
-			return 121
-		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 109: // ['a','m']
+			return 21
+		case r == 110: // ['n','n']
+			return 167
+		case 111 <= r && r <= 122: // ['o','z']
+			return 21
 		}
 		return NoState
 	},
-	// S109
+	// S154
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 113: // ['a','q']
-			return 19
-		case r == 114: // ['r','r']
-			return 122
-		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 107: // ['a','k']
+			return 21
+		case r == 108: // ['l','l']
+			return 168
+		case 109 <= r && r <= 122: // ['m','z']
+			return 21
 		}
 		return NoState
 	},
-	// S110
+	// S155
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 109: // ['a','m']
-			return 19
-		case r == 110: // ['n','n']
-			return 123
-		case 111 <= r && r <= 122: // ['o','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
 		}
 		return NoState
 	},
-	// S111
+	// S156
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S112
+	// S157
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S113
+	// S158
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 100: // ['a','d']
-			return 19
-		case r == 101: // ['e','e']
-			return 124
-		case 102 <= r && r <= 122: // ['f','z']
-			return 19
+			return 21
+		case r == 97: // ['a','a']
+			return 169
+		case 98 <= r && r <= 122: // ['b','z']
+			return 21
+		}
+		return NoState
+	},
+	// S159
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 58
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case 97 <= r && r <= 109: // ['a','m']
+			return 21
+		case r == 110: // ['n','n']
+			return 170
+		case 111 <= r && r <= 122: // ['o','z']
+			return 21
 		}
 		return NoState
 	},
-	// S114
+	// S160
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 113: // ['a','q']
-			return 19
-		case r == 114: // ['r','r']
-			return 125
-		case 115 <= r && r <= 122: // ['s','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 102: // ['a','f']
+			return 21
+		case r == 103: // ['g','g']
+			return 171
+		case 104 <= r && r <= 122: // ['h','z']
+			return 21
 		}
 		return NoState
 	},
-	// S115
+	// S161
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 100: // ['a','d']
-			return 19
-		case r == 101: // ['e','e']
-			return 126
-		case 102 <= r && r <= 122: // ['f','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 115: // ['a','s']
+			return 21
+		case r == 116: // ['t','t']
+			return 172
+		case 117 <= r && r <= 122: // ['u','z']
+			return 21
 		}
 		return NoState
 	},
-	// S116
+	// S162
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 103: // ['a','g']
+			return 21
+		case r == 104: // ['h','h']
+			return 173
+		case 105 <= r && r <= 122: // ['i','z']
+			return 21
 		}
 		return NoState
 	},
-	// S117
+	// S163
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 100: // ['a','d']
-			return 19
-		case r == 101: // ['e','e']
-			return 127
-		case 102 <= r && r <= 122: // ['f','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
 		}
 		return NoState
 	},
-	// S118
+	// S164
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 110: // ['a','n']
+			return 21
+		case r == 111: // ['o','o']
+			return 174
+		case 112 <= r && r <= 122: // ['p','z']
+			return 21
 		}
 		return NoState
 	},
-	// S119
+	// S165
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S120
+	// S166
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S121
+	// S167
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case r == 97: // ['a','a']
-			return 128
-		case 98 <= r && r <= 122: // ['b','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 116: // ['a','t']
+			return 21
+		case r == 117: // ['u','u']
+			return 175
+		case 118 <= r && r <= 122: // ['v','z']
+			return 21
 		}
 		return NoState
 	},
-	// S122
+	// S168
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 109: // ['a','m']
-			return 19
-		case r == 110: // ['n','n']
-			return 129
-		case 111 <= r && r <= 122: // ['o','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 115: // ['a','s']
+			return 21
+		case r == 116: // ['t','t']
+			return 176
+		case 117 <= r && r <= 122: // ['u','z']
+			return 21
 		}
 		return NoState
 	},
-	// S123
+	// S169
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 102: // ['a','f']
-			return 19
-		case r == 103: // ['g','g']
-			return 130
-		case 104 <= r && r <= 122: // ['h','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 108: // ['a','l']
+			return 21
+		case r == 109: // ['m','m']
+			return 177
+		case 110 <= r && r <= 122: // ['n','z']
+			return 21
 		}
 		return NoState
 	},
-	// S124
+	// S170
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S125
+	// S171
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 110: // ['a','n']
-			return 19
-		case r == 111: // ['o','o']
-			return 131
-		case 112 <= r && r <= 122: // ['p','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
 		}
 		return NoState
 	},
-	// S126
+	// S172
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S127
+	// S173
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S128
+	// S174
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 108: // ['a','l']
-			return 19
-		case r == 109: // ['m','m']
-			return 132
-		case 110 <= r && r <= 122: // ['n','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 116: // ['a','t']
+			return 21
+		case r == 117: // ['u','u']
+			return 178
+		case 118 <= r && r <= 122: // ['v','z']
+			return 21
 		}
 		return NoState
 	},
-	// S129
+	// S175
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 100: // ['a','d']
+			return 21
+		case r == 101: // ['e','e']
+			return 179
+		case 102 <= r && r <= 122: // ['f','z']
+			return 21
 		}
 		return NoState
 	},
-	// S130
+	// S176
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S131
+	// S177
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 116: // ['a','t']
-			return 19
-		case r == 117: // ['u','u']
-			return 133
-		case 118 <= r && r <= 122: // ['v','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
 		}
 		return NoState
 	},
-	// S132
+	// S178
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 109: // ['a','m']
+			return 21
+		case r == 110: // ['n','n']
+			return 180
+		case 111 <= r && r <= 122: // ['o','z']
+			return 21
 		}
 		return NoState
 	},
-	// S133
+	// S179
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
-		case 97 <= r && r <= 109: // ['a','m']
-			return 19
-		case r == 110: // ['n','n']
-			return 134
-		case 111 <= r && r <= 122: // ['o','z']
-			return 19
+			return 21
+		case 97 <= r && r <= 122: // ['a','z']
+			return 21
 		}
 		return NoState
 	},
-	// S134
+	// S180
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 99: // ['a','c']
-			return 19
+			return 21
 		case r == 100: // ['d','d']
-			return 135
+			return 181
 		case 101 <= r && r <= 122: // ['e','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
-	// S135
+	// S181
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 58
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 19
+			return 21
 		case 97 <= r && r <= 122: // ['a','z']
-			return 19
+			return 21
 		}
 		return NoState
 	},
@@ -2,7 +2,7 @@
 
 package parser
 
-const numNTSymbols = 32
+const numNTSymbols = 48
 
 type (
 	gotoTable [numStates]gotoRow
@@ -13,7 +13,13 @@ var gotoTab = gotoTable{
 	gotoRow{ // S0
 		-1, // S'
 		1,  // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -27,27 +33,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S1
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -61,27 +83,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S2
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -95,27 +133,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S3
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -129,27 +183,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S4
 		-1, // S'
 		-1, // Programa
+		5,  // StructsOp
+		6,  // Structs
+		7,  // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -163,28 +233,44 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S5
 		-1, // S'
 		-1, // Programa
-		6,  // VarsOp
-		7,  // Vars
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
 		-1, // Params
@@ -194,30 +280,46 @@ var gotoTab = gotoTable{
 		-1, // Block
 		-1, // BlockAux
 		-1, // Statement
-		9,  // BasicType
-		15, // Object
+		-1, // BasicType
+		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
-		8,  // Type
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S6
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -231,27 +333,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S7
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		11, // Structs
+		7,  // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -265,30 +383,46 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S8
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
-		23, // Ids
+		-1, // Ids
 		-1, // Params
 		-1, // ParamsAux
 		-1, // Functions
@@ -299,27 +433,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S9
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -333,29 +483,45 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S10
 		-1, // S'
 		-1, // Programa
-		-1, // VarsOp
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		15, // VarsOp
+		18, // GlobalVars
 		-1, // Vars
-		-1, // VarsDec
+		19, // VarsDec
 		-1, // Ids
 		-1, // Params
 		-1, // ParamsAux
@@ -364,30 +530,46 @@ var gotoTab = gotoTable{
 		-1, // Block
 		-1, // BlockAux
 		-1, // Statement
-		-1, // BasicType
-		-1, // Object
+		21, // BasicType
+		16, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
-		-1, // Type
+		-1, // Indexes
+		17, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S11
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -401,27 +583,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S12
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -435,27 +633,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S13
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -469,27 +683,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S14
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -503,27 +733,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		36, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S15
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -537,27 +783,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S16
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -571,30 +833,46 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S17
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
-		-1, // Ids
+		40, // Ids
 		-1, // Params
 		-1, // ParamsAux
 		-1, // Functions
@@ -605,27 +883,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S18
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -639,29 +933,45 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S19
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		41, // GlobalVars
 		-1, // Vars
-		-1, // VarsDec
+		19, // VarsDec
 		-1, // Ids
 		-1, // Params
 		-1, // ParamsAux
@@ -670,30 +980,46 @@ var gotoTab = gotoTable{
 		-1, // Block
 		-1, // BlockAux
 		-1, // Statement
-		-1, // BasicType
-		-1, // Object
+		21, // BasicType
+		16, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
-		-1, // Type
+		-1, // Indexes
+		17, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S20
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -704,64 +1030,96 @@ var gotoTab = gotoTable{
 		-1, // Block
 		-1, // BlockAux
 		-1, // Statement
-		-1, // BasicType
-		-1, // Object
+		21, // BasicType
+		16, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
-		-1, // Type
+		-1, // Indexes
+		42, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S21
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
 		-1, // Params
 		-1, // ParamsAux
-		25, // Functions
-		27, // FunctionsAux
+		-1, // Functions
+		-1, // FunctionsAux
 		-1, // Block
 		-1, // BlockAux
 		-1, // Statement
-		9,  // BasicType
-		15, // Object
+		-1, // BasicType
+		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
-		26, // Type
+		-1, // Indexes
+		-1, // Type
+		44, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S22
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -775,27 +1133,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S23
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -809,27 +1183,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S24
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -843,27 +1233,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S25
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -877,27 +1283,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S26
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -911,27 +1333,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S27
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -945,27 +1383,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S28
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -979,30 +1433,46 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S29
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
-		33, // Ids
+		-1, // Ids
 		-1, // Params
 		-1, // ParamsAux
 		-1, // Functions
@@ -1013,28 +1483,44 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S30
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
-		34, // Vars
+		-1, // GlobalVars
+		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
 		-1, // Params
@@ -1044,30 +1530,46 @@ var gotoTab = gotoTable{
 		-1, // Block
 		-1, // BlockAux
 		-1, // Statement
-		9,  // BasicType
-		15, // Object
+		-1, // BasicType
+		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
-		8,  // Type
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S31
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1081,27 +1583,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S32
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1115,28 +1633,44 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S33
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
-		-1, // Vars
+		-1, // GlobalVars
+		46, // Vars
 		-1, // VarsDec
 		-1, // Ids
 		-1, // Params
@@ -1146,30 +1680,46 @@ var gotoTab = gotoTable{
 		-1, // Block
 		-1, // BlockAux
 		-1, // Statement
-		-1, // BasicType
-		-1, // Object
+		21, // BasicType
+		16, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
-		-1, // Type
+		-1, // Indexes
+		47, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S34
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		48, // ClassMembers
+		49, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1180,30 +1730,46 @@ var gotoTab = gotoTable{
 		-1, // Block
 		-1, // BlockAux
 		-1, // Statement
-		-1, // BasicType
-		-1, // Object
+		21, // BasicType
+		16, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
-		-1, // Type
+		-1, // Indexes
+		50, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S35
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1215,63 +1781,95 @@ var gotoTab = gotoTable{
 		-1, // BlockAux
 		-1, // Statement
 		-1, // BasicType
-		-1, // Object
+		52, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S36
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
-		38, // Params
-		39, // ParamsAux
+		-1, // Params
+		-1, // ParamsAux
 		-1, // Functions
 		-1, // FunctionsAux
 		-1, // Block
 		-1, // BlockAux
 		-1, // Statement
-		9,  // BasicType
-		15, // Object
+		-1, // BasicType
+		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
-		37, // Type
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S37
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1283,63 +1881,95 @@ var gotoTab = gotoTable{
 		-1, // BlockAux
 		-1, // Statement
 		-1, // BasicType
-		-1, // Object
-		-1, // Expression
-		-1, // Operations
-		-1, // Exp
-		-1, // Term
-		-1, // Factor
+		59, // Object
+		61, // Expression
+		66, // AndExp
+		67, // EqualityExp
+		68, // RelationalExp
+		69, // Exp
+		70, // Term
+		72, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
-		-1, // CallFunction
+		62, // CallFunction
 		-1, // CallFunctionAux
-		-1, // Varcte
-		-1, // ListElem
+		73, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		76, // ListElem
+		-1, // Indexes
 		-1, // Type
-		-1, // Attribute
+		-1, // Dimensions
+		75, // Attribute
 	},
 	gotoRow{ // S38
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
 		-1, // Params
 		-1, // ParamsAux
-		-1, // Functions
-		-1, // FunctionsAux
+		82, // Functions
+		85, // FunctionsAux
 		-1, // Block
 		-1, // BlockAux
 		-1, // Statement
-		-1, // BasicType
-		-1, // Object
+		21, // BasicType
+		16, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
-		-1, // Type
+		-1, // Indexes
+		83, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S39
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1353,27 +1983,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S40
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1387,27 +2033,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S41
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1415,135 +2077,149 @@ var gotoTab = gotoTable{
 		-1, // ParamsAux
 		-1, // Functions
 		-1, // FunctionsAux
-		44, // Block
+		-1, // Block
 		-1, // BlockAux
 		-1, // Statement
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S42
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
 		-1, // Params
-		45, // ParamsAux
+		-1, // ParamsAux
 		-1, // Functions
 		-1, // FunctionsAux
 		-1, // Block
 		-1, // BlockAux
 		-1, // Statement
-		9,  // BasicType
-		15, // Object
+		-1, // BasicType
+		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
-		37, // Type
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S43
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
-		48, // Vars
-		50, // VarsDec
-		-1, // Ids
-		-1, // Params
-		-1, // ParamsAux
-		-1, // Functions
-		-1, // FunctionsAux
-		-1, // Block
-		51, // BlockAux
-		52, // Statement
-		9,  // BasicType
-		15, // Object
-		-1, // Expression
-		-1, // Operations
-		-1, // Exp
-		-1, // Term
-		-1, // Factor
-		53, // Assign
-		58, // Write
-		54, // Condition
-		55, // Return
-		56, // For
-		57, // While
-		59, // CallFunction
-		-1, // CallFunctionAux
-		-1, // Varcte
-		61, // ListElem
-		49, // Type
-		60, // Attribute
-	},
-	gotoRow{ // S44
-		-1, // S'
-		-1, // Programa
-		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
 		-1, // Params
 		-1, // ParamsAux
-		67, // Functions
-		27, // FunctionsAux
+		-1, // Functions
+		-1, // FunctionsAux
 		-1, // Block
 		-1, // BlockAux
 		-1, // Statement
-		9,  // BasicType
-		15, // Object
+		-1, // BasicType
+		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
-		26, // Type
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S45
+	gotoRow{ // S44
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1557,27 +2233,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S46
+	gotoRow{ // S45
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1588,30 +2280,46 @@ var gotoTab = gotoTable{
 		-1, // Block
 		-1, // BlockAux
 		-1, // Statement
-		-1, // BasicType
-		-1, // Object
+		93, // BasicType
+		92, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S47
+	gotoRow{ // S46
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1625,27 +2333,93 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
+	gotoRow{ // S47
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		106, // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
 	gotoRow{ // S48
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1659,30 +2433,146 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 	gotoRow{ // S49
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		108, // ClassMembers
+		49,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		50,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S50
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		110, // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S51
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
-		72, // Ids
+		-1, // Ids
 		-1, // Params
 		-1, // ParamsAux
 		-1, // Functions
@@ -1693,27 +2583,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S50
+	gotoRow{ // S52
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1727,27 +2633,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S51
+	gotoRow{ // S53
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1761,61 +2683,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S52
-		-1, // S'
-		-1, // Programa
-		-1, // VarsOp
-		48, // Vars
-		50, // VarsDec
-		-1, // Ids
-		-1, // Params
-		-1, // ParamsAux
-		-1, // Functions
-		-1, // FunctionsAux
-		-1, // Block
-		74, // BlockAux
-		52, // Statement
-		9,  // BasicType
-		15, // Object
-		-1, // Expression
-		-1, // Operations
-		-1, // Exp
-		-1, // Term
-		-1, // Factor
-		53, // Assign
-		58, // Write
-		54, // Condition
-		55, // Return
-		56, // For
-		57, // While
-		59, // CallFunction
-		-1, // CallFunctionAux
-		-1, // Varcte
-		61, // ListElem
-		49, // Type
-		60, // Attribute
-	},
-	gotoRow{ // S53
+	gotoRow{ // S54
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1829,27 +2733,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S54
+	gotoRow{ // S55
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1863,27 +2783,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S55
+	gotoRow{ // S56
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1897,27 +2833,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S56
+	gotoRow{ // S57
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1931,27 +2883,93 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S57
+	gotoRow{ // S58
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		115, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S59
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -1965,29 +2983,95 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S58
-		-1, // S'
-		-1, // Programa
-		-1, // VarsOp
-		-1, // Vars
-		-1, // VarsDec
+	gotoRow{ // S60
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		121, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S61
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
 		-1, // Ids
 		-1, // Params
 		-1, // ParamsAux
@@ -1999,27 +3083,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S59
+	gotoRow{ // S62
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2033,27 +3133,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S60
+	gotoRow{ // S63
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2067,27 +3183,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S61
+	gotoRow{ // S64
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2101,27 +3233,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S62
+	gotoRow{ // S65
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2135,27 +3283,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S63
+	gotoRow{ // S66
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2169,27 +3333,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S64
+	gotoRow{ // S67
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2202,28 +3382,44 @@ var gotoTab = gotoTable{
 		-1, // Statement
 		-1, // BasicType
 		-1, // Object
-		84, // Expression
-		-1, // Operations
-		85, // Exp
-		86, // Term
-		87, // Factor
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
-		83, // CallFunction
+		-1, // CallFunction
 		-1, // CallFunctionAux
-		88, // Varcte
-		90, // ListElem
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
-		89, // Attribute
+		-1, // Dimensions
+		-1, // Attribute
 	},
-	gotoRow{ // S65
+	gotoRow{ // S68
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2237,27 +3433,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S66
+	gotoRow{ // S69
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2271,27 +3483,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S67
+	gotoRow{ // S70
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2305,95 +3533,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S68
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		102, // Expression
-		-1,  // Operations
-		103, // Exp
-		104, // Term
-		105, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		101, // CallFunction
-		109, // CallFunctionAux
-		106, // Varcte
-		108, // ListElem
-		-1,  // Type
-		107, // Attribute
-	},
-	gotoRow{ // S69
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		115, // Expression
-		-1,  // Operations
-		85,  // Exp
-		86,  // Term
-		87,  // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		83,  // CallFunction
-		-1,  // CallFunctionAux
-		88,  // Varcte
-		90,  // ListElem
-		-1,  // Type
-		89,  // Attribute
-	},
-	gotoRow{ // S70
+	gotoRow{ // S71
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
+		-1,  // GlobalVars
 		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
@@ -2405,29 +3581,45 @@ var gotoTab = gotoTable{
 		-1,  // BlockAux
 		-1,  // Statement
 		-1,  // BasicType
-		-1,  // Object
-		119, // Expression
-		-1,  // Operations
-		120, // Exp
-		121, // Term
-		122, // Factor
+		59,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		155, // Factor
 		-1,  // Assign
 		-1,  // Write
 		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
-		118, // CallFunction
+		62,  // CallFunction
 		-1,  // CallFunctionAux
-		123, // Varcte
-		125, // ListElem
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
 		-1,  // Type
-		124, // Attribute
+		-1,  // Dimensions
+		75,  // Attribute
 	},
-	gotoRow{ // S71
+	gotoRow{ // S72
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2441,27 +3633,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S72
+	gotoRow{ // S73
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2475,27 +3683,93 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S73
+	gotoRow{ // S74
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		156, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
+	},
+	gotoRow{ // S75
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2509,27 +3783,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S74
+	gotoRow{ // S76
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2543,27 +3833,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S75
+	gotoRow{ // S77
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2577,27 +3883,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S76
+	gotoRow{ // S78
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2611,163 +3933,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S77
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		133, // Expression
-		-1,  // Operations
-		85,  // Exp
-		86,  // Term
-		87,  // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		83,  // CallFunction
-		-1,  // CallFunctionAux
-		88,  // Varcte
-		90,  // ListElem
-		-1,  // Type
-		89,  // Attribute
-	},
-	gotoRow{ // S78
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		134, // Expression
-		-1,  // Operations
-		85,  // Exp
-		86,  // Term
-		87,  // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		83,  // CallFunction
-		-1,  // CallFunctionAux
-		88,  // Varcte
-		90,  // ListElem
-		-1,  // Type
-		89,  // Attribute
-	},
 	gotoRow{ // S79
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		138, // Expression
-		-1,  // Operations
-		139, // Exp
-		140, // Term
-		141, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		137, // CallFunction
-		-1,  // CallFunctionAux
-		142, // Varcte
-		144, // ListElem
-		-1,  // Type
-		143, // Attribute
-	},
-	gotoRow{ // S80
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		150, // Expression
-		-1,  // Operations
-		139, // Exp
-		140, // Term
-		141, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		137, // CallFunction
-		-1,  // CallFunctionAux
-		142, // Varcte
-		144, // ListElem
-		-1,  // Type
-		143, // Attribute
-	},
-	gotoRow{ // S81
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2781,61 +3983,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S82
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		154, // Expression
-		-1,  // Operations
-		139, // Exp
-		140, // Term
-		141, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		137, // CallFunction
-		-1,  // CallFunctionAux
-		142, // Varcte
-		144, // ListElem
-		-1,  // Type
-		143, // Attribute
-	},
-	gotoRow{ // S83
+	gotoRow{ // S80
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2849,27 +4033,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S84
+	gotoRow{ // S81
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2883,61 +4083,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S85
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		156, // Operations
-		-1,  // Exp
-		-1,  // Term
-		-1,  // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		-1,  // CallFunction
-		-1,  // CallFunctionAux
-		-1,  // Varcte
-		-1,  // ListElem
-		-1,  // Type
-		-1,  // Attribute
-	},
-	gotoRow{ // S86
+	gotoRow{ // S82
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2951,27 +4133,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S87
+	gotoRow{ // S83
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -2985,27 +4183,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S88
+	gotoRow{ // S84
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3019,27 +4233,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S89
+	gotoRow{ // S85
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3053,27 +4283,143 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S90
+	gotoRow{ // S86
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		162, // Expression
+		167, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S87
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		183, // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S88
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3087,27 +4433,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S91
+	gotoRow{ // S89
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3121,27 +4483,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S92
+	gotoRow{ // S90
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3155,27 +4533,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S93
+	gotoRow{ // S91
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3189,27 +4583,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S94
+	gotoRow{ // S92
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3223,27 +4633,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S95
+	gotoRow{ // S93
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3257,95 +4683,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S96
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		-1,  // Exp
-		-1,  // Term
-		-1,  // Factor
-		164, // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		-1,  // CallFunction
-		-1,  // CallFunctionAux
-		-1,  // Varcte
-		61,  // ListElem
-		-1,  // Type
-		60,  // Attribute
-	},
-	gotoRow{ // S97
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		165, // Expression
-		-1,  // Operations
-		139, // Exp
-		140, // Term
-		141, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		137, // CallFunction
-		-1,  // CallFunctionAux
-		142, // Varcte
-		144, // ListElem
-		-1,  // Type
-		143, // Attribute
-	},
-	gotoRow{ // S98
+	gotoRow{ // S94
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3359,61 +4733,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S99
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		169, // Expression
-		-1,  // Operations
-		139, // Exp
-		140, // Term
-		141, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		137, // CallFunction
-		-1,  // CallFunctionAux
-		142, // Varcte
-		144, // ListElem
-		-1,  // Type
-		143, // Attribute
-	},
-	gotoRow{ // S100
+	gotoRow{ // S95
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3427,27 +4783,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S101
+	gotoRow{ // S96
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3461,27 +4833,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S102
+	gotoRow{ // S97
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3495,61 +4883,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S103
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		171, // Operations
-		-1,  // Exp
-		-1,  // Term
-		-1,  // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		-1,  // CallFunction
-		-1,  // CallFunctionAux
-		-1,  // Varcte
-		-1,  // ListElem
-		-1,  // Type
-		-1,  // Attribute
-	},
-	gotoRow{ // S104
+	gotoRow{ // S98
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3563,27 +4933,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S105
+	gotoRow{ // S99
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3597,27 +4983,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S106
+	gotoRow{ // S100
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3631,27 +5033,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S107
+	gotoRow{ // S101
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3665,27 +5083,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S108
+	gotoRow{ // S102
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3699,27 +5133,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S109
+	gotoRow{ // S103
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3733,27 +5183,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S110
+	gotoRow{ // S104
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3767,27 +5233,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S111
+	gotoRow{ // S105
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3801,27 +5283,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S112
+	gotoRow{ // S106
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3835,27 +5333,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S113
+	gotoRow{ // S107
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3869,27 +5383,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S114
+	gotoRow{ // S108
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3903,27 +5433,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S115
+	gotoRow{ // S109
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3937,27 +5483,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S116
+	gotoRow{ // S110
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -3971,27 +5533,93 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S117
+	gotoRow{ // S111
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S112
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		192, // ClassMembers
+		49,  // ClassMember
 		-1,  // VarsOp
+		-1,  // GlobalVars
 		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
@@ -4002,30 +5630,96 @@ var gotoTab = gotoTable{
 		-1,  // Block
 		-1,  // BlockAux
 		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		180, // Expression
-		-1,  // Operations
-		139, // Exp
-		140, // Term
-		141, // Factor
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
 		-1,  // Assign
 		-1,  // Write
 		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
-		137, // CallFunction
+		-1,  // CallFunction
 		-1,  // CallFunctionAux
-		142, // Varcte
-		144, // ListElem
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		50,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S113
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		197, // Expression
+		202, // AndExp
+		203, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		213, // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
 		-1,  // Type
-		143, // Attribute
+		-1,  // Dimensions
+		211, // Attribute
 	},
-	gotoRow{ // S118
+	gotoRow{ // S114
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4039,27 +5733,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S119
+	gotoRow{ // S115
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4073,27 +5783,93 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S120
+	gotoRow{ // S116
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		220, // Expression
+		66,  // AndExp
+		67,  // EqualityExp
+		68,  // RelationalExp
+		69,  // Exp
+		70,  // Term
+		72,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
+	},
+	gotoRow{ // S117
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
+		-1,  // GlobalVars
 		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
@@ -4107,27 +5883,93 @@ var gotoTab = gotoTable{
 		-1,  // BasicType
 		-1,  // Object
 		-1,  // Expression
-		182, // Operations
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
 		-1,  // Exp
 		-1,  // Term
 		-1,  // Factor
 		-1,  // Assign
 		-1,  // Write
 		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
 		-1,  // CallFunction
 		-1,  // CallFunctionAux
 		-1,  // Varcte
+		223, // FieldInits
+		224, // FieldInit
 		-1,  // ListElem
+		-1,  // Indexes
 		-1,  // Type
+		-1,  // Dimensions
 		-1,  // Attribute
 	},
-	gotoRow{ // S121
+	gotoRow{ // S118
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		227, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S119
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4141,27 +5983,93 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S122
+	gotoRow{ // S120
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		230, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S121
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4175,27 +6083,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S123
+	gotoRow{ // S122
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4209,27 +6133,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S124
+	gotoRow{ // S123
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4243,27 +6183,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S125
+	gotoRow{ // S124
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4277,27 +6233,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S126
+	gotoRow{ // S125
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4311,27 +6283,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S127
+	gotoRow{ // S126
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4345,27 +6333,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S128
+	gotoRow{ // S127
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4379,27 +6383,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S129
+	gotoRow{ // S128
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4413,27 +6433,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S130
+	gotoRow{ // S129
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4447,27 +6483,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S131
+	gotoRow{ // S130
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4481,28 +6533,44 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S132
+	gotoRow{ // S131
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
-		187, // Vars
+		-1,  // GlobalVars
+		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
 		-1,  // Params
@@ -4512,64 +6580,46 @@ var gotoTab = gotoTable{
 		-1,  // Block
 		-1,  // BlockAux
 		-1,  // Statement
-		9,   // BasicType
-		15,  // Object
+		-1,  // BasicType
+		119, // Object
 		-1,  // Expression
-		-1,  // Operations
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
 		-1,  // Exp
 		-1,  // Term
-		-1,  // Factor
+		244, // Factor
 		-1,  // Assign
 		-1,  // Write
 		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
-		-1,  // CallFunction
+		122, // CallFunction
 		-1,  // CallFunctionAux
-		-1,  // Varcte
-		-1,  // ListElem
-		49,  // Type
-		-1,  // Attribute
-	},
-	gotoRow{ // S133
-		-1, // S'
-		-1, // Programa
-		-1, // VarsOp
-		-1, // Vars
-		-1, // VarsDec
-		-1, // Ids
-		-1, // Params
-		-1, // ParamsAux
-		-1, // Functions
-		-1, // FunctionsAux
-		-1, // Block
-		-1, // BlockAux
-		-1, // Statement
-		-1, // BasicType
-		-1, // Object
-		-1, // Expression
-		-1, // Operations
-		-1, // Exp
-		-1, // Term
-		-1, // Factor
-		-1, // Assign
-		-1, // Write
-		-1, // Condition
-		-1, // Return
-		-1, // For
-		-1, // While
-		-1, // CallFunction
-		-1, // CallFunctionAux
-		-1, // Varcte
-		-1, // ListElem
-		-1, // Type
-		-1, // Attribute
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
 	},
-	gotoRow{ // S134
+	gotoRow{ // S132
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4583,27 +6633,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S135
+	gotoRow{ // S133
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4617,27 +6683,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S136
+	gotoRow{ // S134
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
+		-1,  // GlobalVars
 		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
@@ -4649,29 +6731,45 @@ var gotoTab = gotoTable{
 		-1,  // BlockAux
 		-1,  // Statement
 		-1,  // BasicType
-		-1,  // Object
-		191, // Expression
-		-1,  // Operations
-		139, // Exp
-		140, // Term
-		141, // Factor
+		119, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		245, // Factor
 		-1,  // Assign
 		-1,  // Write
 		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
-		137, // CallFunction
+		122, // CallFunction
 		-1,  // CallFunctionAux
-		142, // Varcte
-		144, // ListElem
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
 		-1,  // Type
-		143, // Attribute
+		-1,  // Dimensions
+		135, // Attribute
 	},
-	gotoRow{ // S137
+	gotoRow{ // S135
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4685,265 +6783,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S138
-		-1, // S'
-		-1, // Programa
-		-1, // VarsOp
-		-1, // Vars
-		-1, // VarsDec
-		-1, // Ids
-		-1, // Params
-		-1, // ParamsAux
-		-1, // Functions
-		-1, // FunctionsAux
-		-1, // Block
-		-1, // BlockAux
-		-1, // Statement
-		-1, // BasicType
-		-1, // Object
-		-1, // Expression
-		-1, // Operations
-		-1, // Exp
-		-1, // Term
-		-1, // Factor
-		-1, // Assign
-		-1, // Write
-		-1, // Condition
-		-1, // Return
-		-1, // For
-		-1, // While
-		-1, // CallFunction
-		-1, // CallFunctionAux
-		-1, // Varcte
-		-1, // ListElem
-		-1, // Type
-		-1, // Attribute
-	},
-	gotoRow{ // S139
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		193, // Operations
-		-1,  // Exp
-		-1,  // Term
-		-1,  // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		-1,  // CallFunction
-		-1,  // CallFunctionAux
-		-1,  // Varcte
-		-1,  // ListElem
-		-1,  // Type
-		-1,  // Attribute
-	},
-	gotoRow{ // S140
-		-1, // S'
-		-1, // Programa
-		-1, // VarsOp
-		-1, // Vars
-		-1, // VarsDec
-		-1, // Ids
-		-1, // Params
-		-1, // ParamsAux
-		-1, // Functions
-		-1, // FunctionsAux
-		-1, // Block
-		-1, // BlockAux
-		-1, // Statement
-		-1, // BasicType
-		-1, // Object
-		-1, // Expression
-		-1, // Operations
-		-1, // Exp
-		-1, // Term
-		-1, // Factor
-		-1, // Assign
-		-1, // Write
-		-1, // Condition
-		-1, // Return
-		-1, // For
-		-1, // While
-		-1, // CallFunction
-		-1, // CallFunctionAux
-		-1, // Varcte
-		-1, // ListElem
-		-1, // Type
-		-1, // Attribute
-	},
-	gotoRow{ // S141
-		-1, // S'
-		-1, // Programa
-		-1, // VarsOp
-		-1, // Vars
-		-1, // VarsDec
-		-1, // Ids
-		-1, // Params
-		-1, // ParamsAux
-		-1, // Functions
-		-1, // FunctionsAux
-		-1, // Block
-		-1, // BlockAux
-		-1, // Statement
-		-1, // BasicType
-		-1, // Object
-		-1, // Expression
-		-1, // Operations
-		-1, // Exp
-		-1, // Term
-		-1, // Factor
-		-1, // Assign
-		-1, // Write
-		-1, // Condition
-		-1, // Return
-		-1, // For
-		-1, // While
-		-1, // CallFunction
-		-1, // CallFunctionAux
-		-1, // Varcte
-		-1, // ListElem
-		-1, // Type
-		-1, // Attribute
-	},
-	gotoRow{ // S142
-		-1, // S'
-		-1, // Programa
-		-1, // VarsOp
-		-1, // Vars
-		-1, // VarsDec
-		-1, // Ids
-		-1, // Params
-		-1, // ParamsAux
-		-1, // Functions
-		-1, // FunctionsAux
-		-1, // Block
-		-1, // BlockAux
-		-1, // Statement
-		-1, // BasicType
-		-1, // Object
-		-1, // Expression
-		-1, // Operations
-		-1, // Exp
-		-1, // Term
-		-1, // Factor
-		-1, // Assign
-		-1, // Write
-		-1, // Condition
-		-1, // Return
-		-1, // For
-		-1, // While
-		-1, // CallFunction
-		-1, // CallFunctionAux
-		-1, // Varcte
-		-1, // ListElem
-		-1, // Type
-		-1, // Attribute
-	},
-	gotoRow{ // S143
-		-1, // S'
-		-1, // Programa
-		-1, // VarsOp
-		-1, // Vars
-		-1, // VarsDec
-		-1, // Ids
-		-1, // Params
-		-1, // ParamsAux
-		-1, // Functions
-		-1, // FunctionsAux
-		-1, // Block
-		-1, // BlockAux
-		-1, // Statement
-		-1, // BasicType
-		-1, // Object
-		-1, // Expression
-		-1, // Operations
-		-1, // Exp
-		-1, // Term
-		-1, // Factor
-		-1, // Assign
-		-1, // Write
-		-1, // Condition
-		-1, // Return
-		-1, // For
-		-1, // While
-		-1, // CallFunction
-		-1, // CallFunctionAux
-		-1, // Varcte
-		-1, // ListElem
-		-1, // Type
-		-1, // Attribute
-	},
-	gotoRow{ // S144
-		-1, // S'
-		-1, // Programa
-		-1, // VarsOp
-		-1, // Vars
-		-1, // VarsDec
-		-1, // Ids
-		-1, // Params
-		-1, // ParamsAux
-		-1, // Functions
-		-1, // FunctionsAux
-		-1, // Block
-		-1, // BlockAux
-		-1, // Statement
-		-1, // BasicType
-		-1, // Object
-		-1, // Expression
-		-1, // Operations
-		-1, // Exp
-		-1, // Term
-		-1, // Factor
-		-1, // Assign
-		-1, // Write
-		-1, // Condition
-		-1, // Return
-		-1, // For
-		-1, // While
-		-1, // CallFunction
-		-1, // CallFunctionAux
-		-1, // Varcte
-		-1, // ListElem
-		-1, // Type
-		-1, // Attribute
-	},
-	gotoRow{ // S145
+	gotoRow{ // S136
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4957,27 +6833,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S146
+	gotoRow{ // S137
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -4991,27 +6883,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S147
+	gotoRow{ // S138
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -5025,27 +6933,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S148
+	gotoRow{ // S139
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -5059,27 +6983,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S149
+	gotoRow{ // S140
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -5093,27 +7033,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S150
+	gotoRow{ // S141
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -5127,27 +7083,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S151
+	gotoRow{ // S142
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
+		-1,  // GlobalVars
 		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
@@ -5159,29 +7131,45 @@ var gotoTab = gotoTable{
 		-1,  // BlockAux
 		-1,  // Statement
 		-1,  // BasicType
-		-1,  // Object
-		102, // Expression
-		-1,  // Operations
-		103, // Exp
-		104, // Term
-		105, // Factor
+		59,  // Object
+		-1,  // Expression
+		247, // AndExp
+		67,  // EqualityExp
+		68,  // RelationalExp
+		69,  // Exp
+		70,  // Term
+		72,  // Factor
 		-1,  // Assign
 		-1,  // Write
 		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
-		101, // CallFunction
-		200, // CallFunctionAux
-		106, // Varcte
-		108, // ListElem
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
 		-1,  // Type
-		107, // Attribute
+		-1,  // Dimensions
+		75,  // Attribute
 	},
-	gotoRow{ // S152
+	gotoRow{ // S143
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
+		-1,  // GlobalVars
 		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
@@ -5194,28 +7182,26844 @@ var gotoTab = gotoTable{
 		-1,  // Statement
 		-1,  // BasicType
 		-1,  // Object
-		201, // Expression
-		-1,  // Operations
-		120, // Exp
-		121, // Term
-		122, // Factor
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
 		-1,  // Assign
 		-1,  // Write
 		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
-		118, // CallFunction
+		-1,  // CallFunction
 		-1,  // CallFunctionAux
-		123, // Varcte
-		125, // ListElem
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		248, // Indexes
 		-1,  // Type
-		124, // Attribute
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S144
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		249, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S145
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		250, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S146
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		251, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S147
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		252, // EqualityExp
+		68,  // RelationalExp
+		69,  // Exp
+		70,  // Term
+		72,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
+	},
+	gotoRow{ // S148
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		253, // RelationalExp
+		69,  // Exp
+		70,  // Term
+		72,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
+	},
+	gotoRow{ // S149
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		254, // Exp
+		70,  // Term
+		72,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
+	},
+	gotoRow{ // S150
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		255, // Term
+		72,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
+	},
+	gotoRow{ // S151
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		256, // Term
+		72,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
+	},
+	gotoRow{ // S152
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		257, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
 	},
 	gotoRow{ // S153
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		258, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
+	},
+	gotoRow{ // S154
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		259, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
+	},
+	gotoRow{ // S155
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S156
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S157
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S158
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S159
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		264, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S160
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S161
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		267, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S162
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S163
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S164
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S165
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S166
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S167
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S168
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S169
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S170
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S171
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S172
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		281, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S173
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S174
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S175
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		282, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S176
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S177
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S178
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S179
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S180
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S181
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S182
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S183
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S184
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		284, // Expression
+		167, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S185
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		285, // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S186
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S187
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S188
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		286, // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		47,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S189
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		288, // Params
+		289, // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		287, // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S190
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S191
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		290, // Params
+		289, // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		287, // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S192
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S193
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		294, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S194
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S195
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		297, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S196
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S197
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S198
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S199
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S200
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S201
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S202
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S203
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S204
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S205
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S206
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S207
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		311, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		-1,  // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S208
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S209
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S210
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		312, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		-1,  // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S211
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S212
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S213
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S214
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S215
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S216
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S217
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S218
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S219
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S220
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S221
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S222
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S223
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S224
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S225
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		197, // Expression
+		202, // AndExp
+		203, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		321, // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S226
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S227
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S228
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		323, // Expression
+		66,  // AndExp
+		67,  // EqualityExp
+		68,  // RelationalExp
+		69,  // Exp
+		70,  // Term
+		72,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
+	},
+	gotoRow{ // S229
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		325, // FieldInits
+		224, // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S230
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S231
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S232
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		-1,  // Expression
+		327, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S233
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		328, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S234
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		329, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S235
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		330, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S236
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		331, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S237
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		332, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S238
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		333, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S239
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		334, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S240
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		335, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S241
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		336, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S242
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		337, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S243
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		338, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S244
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S245
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S246
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S247
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S248
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S249
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S250
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S251
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S252
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S253
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S254
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S255
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S256
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S257
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S258
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S259
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S260
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S261
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		343, // Params
+		289, // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		287, // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S262
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		197, // Expression
+		202, // AndExp
+		203, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		345, // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S263
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S264
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S265
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		347, // Expression
+		66,  // AndExp
+		67,  // EqualityExp
+		68,  // RelationalExp
+		69,  // Exp
+		70,  // Term
+		72,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
+	},
+	gotoRow{ // S266
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		349, // FieldInits
+		224, // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S267
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S268
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S269
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		-1,  // Expression
+		351, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S270
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		352, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S271
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		353, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S272
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		354, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S273
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		355, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S274
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		356, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S275
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		357, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S276
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		358, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S277
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		359, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S278
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		360, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S279
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		361, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S280
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		362, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S281
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S282
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S283
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S284
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S285
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S286
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S287
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S288
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S289
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S290
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S291
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S292
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		197, // Expression
+		202, // AndExp
+		203, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		369, // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S293
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S294
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S295
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		371, // Expression
+		66,  // AndExp
+		67,  // EqualityExp
+		68,  // RelationalExp
+		69,  // Exp
+		70,  // Term
+		72,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
+	},
+	gotoRow{ // S296
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		373, // FieldInits
+		224, // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S297
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S298
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		197, // Expression
+		202, // AndExp
+		203, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		375, // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S299
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		-1,  // Expression
+		376, // AndExp
+		203, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		-1,  // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S300
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		377, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S301
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		378, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S302
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		379, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S303
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		380, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		-1,  // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S304
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		381, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		-1,  // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S305
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		382, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		-1,  // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S306
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		383, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		-1,  // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S307
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		384, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		-1,  // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S308
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		385, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		-1,  // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S309
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		386, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		-1,  // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S310
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		387, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		-1,  // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S311
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S312
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S313
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S314
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S315
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		197, // Expression
+		202, // AndExp
+		203, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		390, // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S316
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		391, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S317
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		393, // Object
+		395, // Expression
+		400, // AndExp
+		401, // EqualityExp
+		402, // RelationalExp
+		403, // Exp
+		404, // Term
+		406, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		396, // CallFunction
+		-1,  // CallFunctionAux
+		407, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		410, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		409, // Attribute
+	},
+	gotoRow{ // S318
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S319
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		416, // FieldInits
+		224, // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S320
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S321
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S322
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S323
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S324
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S325
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S326
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S327
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S328
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S329
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S330
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S331
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S332
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S333
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S334
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S335
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S336
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S337
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S338
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S339
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S340
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S341
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S342
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S343
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S344
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S345
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S346
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S347
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S348
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S349
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S350
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S351
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S352
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S353
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S354
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S355
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S356
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S357
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S358
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S359
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S360
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S361
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S362
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S363
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S364
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S365
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S366
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		434, // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S367
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		435, // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S368
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S369
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S370
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S371
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S372
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S373
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S374
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S375
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S376
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S377
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S378
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S379
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S380
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S381
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S382
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S383
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S384
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S385
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S386
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S387
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S388
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S389
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S390
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S391
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S392
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		446, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S393
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S394
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		449, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S395
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S396
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S397
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S398
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S399
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S400
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S401
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S402
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S403
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S404
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S405
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		393, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		462, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		396, // CallFunction
+		-1,  // CallFunctionAux
+		407, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		410, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		409, // Attribute
+	},
+	gotoRow{ // S406
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S407
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S408
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		393, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		463, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		396, // CallFunction
+		-1,  // CallFunctionAux
+		407, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		410, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		409, // Attribute
+	},
+	gotoRow{ // S409
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S410
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S411
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S412
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S413
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S414
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S415
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S416
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S417
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S418
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		197, // Expression
+		202, // AndExp
+		203, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		466, // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S419
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		467, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S420
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S421
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S422
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S423
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S424
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		469, // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S425
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S426
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		197, // Expression
+		202, // AndExp
+		203, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		471, // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S427
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		472, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S428
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S429
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S430
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S431
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S432
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		473, // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		287, // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S433
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		477, // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		479, // BlockAux
+		480, // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		481, // Assign
+		487, // Write
+		482, // Condition
+		483, // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		484, // Return
+		485, // For
+		486, // While
+		488, // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		492, // ListElem
+		-1,  // Indexes
+		476, // Type
+		-1,  // Dimensions
+		491, // Attribute
+	},
+	gotoRow{ // S434
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S435
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S436
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S437
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		197, // Expression
+		202, // AndExp
+		203, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		500, // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S438
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		501, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S439
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S440
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S441
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S442
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S443
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S444
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		197, // Expression
+		202, // AndExp
+		203, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		503, // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S445
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S446
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S447
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		505, // Expression
+		66,  // AndExp
+		67,  // EqualityExp
+		68,  // RelationalExp
+		69,  // Exp
+		70,  // Term
+		72,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
+	},
+	gotoRow{ // S448
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		507, // FieldInits
+		224, // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S449
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S450
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		393, // Object
+		-1,  // Expression
+		509, // AndExp
+		401, // EqualityExp
+		402, // RelationalExp
+		403, // Exp
+		404, // Term
+		406, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		396, // CallFunction
+		-1,  // CallFunctionAux
+		407, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		410, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		409, // Attribute
+	},
+	gotoRow{ // S451
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		510, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S452
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		511, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S453
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		512, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S454
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		393, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		513, // EqualityExp
+		402, // RelationalExp
+		403, // Exp
+		404, // Term
+		406, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		396, // CallFunction
+		-1,  // CallFunctionAux
+		407, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		410, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		409, // Attribute
+	},
+	gotoRow{ // S455
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		393, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		514, // RelationalExp
+		403, // Exp
+		404, // Term
+		406, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		396, // CallFunction
+		-1,  // CallFunctionAux
+		407, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		410, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		409, // Attribute
+	},
+	gotoRow{ // S456
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		393, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		515, // Exp
+		404, // Term
+		406, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		396, // CallFunction
+		-1,  // CallFunctionAux
+		407, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		410, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		409, // Attribute
+	},
+	gotoRow{ // S457
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		393, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		516, // Term
+		406, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		396, // CallFunction
+		-1,  // CallFunctionAux
+		407, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		410, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		409, // Attribute
+	},
+	gotoRow{ // S458
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		393, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		517, // Term
+		406, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		396, // CallFunction
+		-1,  // CallFunctionAux
+		407, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		410, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		409, // Attribute
+	},
+	gotoRow{ // S459
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		393, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		518, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		396, // CallFunction
+		-1,  // CallFunctionAux
+		407, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		410, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		409, // Attribute
+	},
+	gotoRow{ // S460
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		393, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		519, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		396, // CallFunction
+		-1,  // CallFunctionAux
+		407, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		410, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		409, // Attribute
+	},
+	gotoRow{ // S461
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		393, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		520, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		396, // CallFunction
+		-1,  // CallFunctionAux
+		407, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		410, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		409, // Attribute
+	},
+	gotoRow{ // S462
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S463
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S464
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S465
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S466
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S467
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S468
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		477, // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		524, // BlockAux
+		480, // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		481, // Assign
+		487, // Write
+		482, // Condition
+		483, // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		484, // Return
+		485, // For
+		486, // While
+		488, // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		492, // ListElem
+		-1,  // Indexes
+		476, // Type
+		-1,  // Dimensions
+		491, // Attribute
+	},
+	gotoRow{ // S469
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		525, // Functions
+		85,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		83,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S470
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S471
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S472
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S473
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S474
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		532, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S475
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S476
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		535, // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S477
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S478
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		536, // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S479
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S480
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		477, // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		538, // BlockAux
+		480, // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		481, // Assign
+		487, // Write
+		482, // Condition
+		483, // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		484, // Return
+		485, // For
+		486, // While
+		488, // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		492, // ListElem
+		-1,  // Indexes
+		476, // Type
+		-1,  // Dimensions
+		491, // Attribute
+	},
+	gotoRow{ // S481
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S482
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S483
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S484
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S485
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S486
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S487
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S488
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S489
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S490
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S491
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S492
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S493
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S494
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S495
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S496
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		553, // Expression
+		167, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S497
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S498
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S499
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S500
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S501
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S502
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S503
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S504
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S505
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S506
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S507
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S508
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S509
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S510
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S511
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S512
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S513
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S514
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S515
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S516
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S517
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S518
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S519
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S520
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S521
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S522
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S523
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S524
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S525
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S526
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S527
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		197, // Expression
+		202, // AndExp
+		203, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		566, // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S528
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		567, // Expression
+		167, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S529
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		568, // Expression
+		167, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S530
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S531
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S532
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S533
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		570, // Expression
+		66,  // AndExp
+		67,  // EqualityExp
+		68,  // RelationalExp
+		69,  // Exp
+		70,  // Term
+		72,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
+	},
+	gotoRow{ // S534
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S535
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S536
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S537
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S538
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S539
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S540
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S541
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S542
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S543
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		574, // Expression
+		167, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S544
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		575, // Expression
+		167, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S545
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S546
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		576, // Expression
+		167, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S547
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		577, // Expression
+		167, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S548
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S549
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S550
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		579, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S551
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		580, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S552
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		581, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S553
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S554
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		584, // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		492, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		491, // Attribute
+	},
+	gotoRow{ // S555
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		585, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S556
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S557
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S558
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		197, // Expression
+		202, // AndExp
+		203, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		587, // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S559
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		588, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S560
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S561
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S562
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S563
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S564
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S565
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S566
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S567
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S568
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S569
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S570
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S571
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		592, // Expression
+		167, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S572
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S573
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S574
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S575
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S576
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S577
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S578
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S579
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S580
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S581
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S582
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S583
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		598, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S584
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S585
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S586
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S587
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S588
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S589
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S590
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		197, // Expression
+		202, // AndExp
+		203, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		604, // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S591
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		605, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S592
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S593
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		607, // Expression
+		167, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S594
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S595
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		610, // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S596
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S597
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S598
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S599
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		613, // Expression
+		66,  // AndExp
+		67,  // EqualityExp
+		68,  // RelationalExp
+		69,  // Exp
+		70,  // Term
+		72,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
+	},
+	gotoRow{ // S600
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		614, // Expression
+		167, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S601
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		616, // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S602
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S603
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S604
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S605
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S606
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S607
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S608
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S609
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		477, // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		620, // BlockAux
+		480, // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		481, // Assign
+		487, // Write
+		482, // Condition
+		483, // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		484, // Return
+		485, // For
+		486, // While
+		488, // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		492, // ListElem
+		-1,  // Indexes
+		476, // Type
+		-1,  // Dimensions
+		491, // Attribute
+	},
+	gotoRow{ // S610
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S611
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		622, // Cases
+		623, // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S612
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S613
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S614
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S615
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		477, // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		629, // BlockAux
+		480, // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		481, // Assign
+		487, // Write
+		482, // Condition
+		483, // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		484, // Return
+		485, // For
+		486, // While
+		488, // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		492, // ListElem
+		-1,  // Indexes
+		476, // Type
+		-1,  // Dimensions
+		491, // Attribute
+	},
+	gotoRow{ // S616
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S617
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S618
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S619
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S620
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S621
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		631, // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		632, // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S622
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S623
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		634, // Cases
+		623, // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S624
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		636, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		643, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		648, // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		638, // CallFunction
+		-1,  // CallFunctionAux
+		644, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		647, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		646, // Attribute
+	},
+	gotoRow{ // S625
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S626
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		655, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S627
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		657, // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		659, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		658, // Attribute
+	},
+	gotoRow{ // S628
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S629
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S630
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S631
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S632
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S633
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S634
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S635
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		663, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S636
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S637
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		666, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S638
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S639
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S640
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S641
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S642
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		636, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		670, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		638, // CallFunction
+		-1,  // CallFunctionAux
+		644, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		647, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		646, // Attribute
+	},
+	gotoRow{ // S643
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S644
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S645
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		636, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		672, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		638, // CallFunction
+		-1,  // CallFunctionAux
+		644, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		647, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		646, // Attribute
+	},
+	gotoRow{ // S646
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S647
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S648
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S649
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S650
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S651
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S652
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S653
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S654
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		676, // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		678, // BlockAux
+		679, // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		680, // Assign
+		686, // Write
+		681, // Condition
+		682, // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		683, // Return
+		684, // For
+		685, // While
+		687, // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		492, // ListElem
+		-1,  // Indexes
+		675, // Type
+		-1,  // Dimensions
+		491, // Attribute
+	},
+	gotoRow{ // S655
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S656
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		598, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S657
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S658
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S659
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S660
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S661
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		197, // Expression
+		202, // AndExp
+		203, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		707, // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S662
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S663
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S664
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		59,  // Object
+		709, // Expression
+		66,  // AndExp
+		67,  // EqualityExp
+		68,  // RelationalExp
+		69,  // Exp
+		70,  // Term
+		72,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		62,  // CallFunction
+		-1,  // CallFunctionAux
+		73,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		76,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		75,  // Attribute
+	},
+	gotoRow{ // S665
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		711, // FieldInits
+		224, // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S666
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S667
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		713, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S668
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		714, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S669
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		715, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S670
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S671
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		636, // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		643, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		716, // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		638, // CallFunction
+		-1,  // CallFunctionAux
+		644, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		647, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		646, // Attribute
+	},
+	gotoRow{ // S672
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S673
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S674
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		676, // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		718, // BlockAux
+		679, // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		680, // Assign
+		686, // Write
+		681, // Condition
+		682, // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		683, // Return
+		684, // For
+		685, // While
+		687, // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		492, // ListElem
+		-1,  // Indexes
+		675, // Type
+		-1,  // Dimensions
+		491, // Attribute
+	},
+	gotoRow{ // S675
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		720, // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S676
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S677
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		721, // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S678
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
+	},
+	gotoRow{ // S679
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		676, // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		722, // BlockAux
+		679, // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		680, // Assign
+		686, // Write
+		681, // Condition
+		682, // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		683, // Return
+		684, // For
+		685, // While
+		687, // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		492, // ListElem
+		-1,  // Indexes
+		675, // Type
+		-1,  // Dimensions
+		491, // Attribute
+	},
+	gotoRow{ // S680
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -5229,27 +34033,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S154
+	gotoRow{ // S681
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -5263,27 +34083,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S155
+	gotoRow{ // S682
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -5297,61 +34133,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S156
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		204, // Expression
-		-1,  // Operations
-		85,  // Exp
-		86,  // Term
-		87,  // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		83,  // CallFunction
-		-1,  // CallFunctionAux
-		88,  // Varcte
-		90,  // ListElem
-		-1,  // Type
-		89,  // Attribute
-	},
-	gotoRow{ // S157
+	gotoRow{ // S683
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -5365,27 +34183,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S158
+	gotoRow{ // S684
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -5399,163 +34233,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S159
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		205, // Exp
-		86,  // Term
-		87,  // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		83,  // CallFunction
-		-1,  // CallFunctionAux
-		88,  // Varcte
-		90,  // ListElem
-		-1,  // Type
-		89,  // Attribute
-	},
-	gotoRow{ // S160
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		206, // Exp
-		86,  // Term
-		87,  // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		83,  // CallFunction
-		-1,  // CallFunctionAux
-		88,  // Varcte
-		90,  // ListElem
-		-1,  // Type
-		89,  // Attribute
-	},
-	gotoRow{ // S161
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		-1,  // Exp
-		207, // Term
-		87,  // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		83,  // CallFunction
-		-1,  // CallFunctionAux
-		88,  // Varcte
-		90,  // ListElem
-		-1,  // Type
-		89,  // Attribute
-	},
-	gotoRow{ // S162
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		-1,  // Exp
-		208, // Term
-		87,  // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		83,  // CallFunction
-		-1,  // CallFunctionAux
-		88,  // Varcte
-		90,  // ListElem
-		-1,  // Type
-		89,  // Attribute
-	},
-	gotoRow{ // S163
+	gotoRow{ // S685
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -5569,27 +34283,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S164
+	gotoRow{ // S686
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -5603,27 +34333,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S165
+	gotoRow{ // S687
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -5637,95 +34383,143 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S166
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		102, // Expression
-		-1,  // Operations
-		103, // Exp
-		104, // Term
-		105, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		101, // CallFunction
-		212, // CallFunctionAux
-		106, // Varcte
-		108, // ListElem
-		-1,  // Type
-		107, // Attribute
+	gotoRow{ // S688
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
 	},
-	gotoRow{ // S167
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		213, // Expression
-		-1,  // Operations
-		120, // Exp
-		121, // Term
-		122, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		118, // CallFunction
-		-1,  // CallFunctionAux
-		123, // Varcte
-		125, // ListElem
-		-1,  // Type
-		124, // Attribute
+	gotoRow{ // S689
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
 	},
-	gotoRow{ // S168
+	gotoRow{ // S690
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -5739,27 +34533,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S169
+	gotoRow{ // S691
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -5773,231 +34583,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S170
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		102, // Expression
-		-1,  // Operations
-		103, // Exp
-		104, // Term
-		105, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		101, // CallFunction
-		216, // CallFunctionAux
-		106, // Varcte
-		108, // ListElem
-		-1,  // Type
-		107, // Attribute
-	},
-	gotoRow{ // S171
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		217, // Expression
-		-1,  // Operations
-		103, // Exp
-		104, // Term
-		105, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		101, // CallFunction
-		-1,  // CallFunctionAux
-		106, // Varcte
-		108, // ListElem
-		-1,  // Type
-		107, // Attribute
-	},
-	gotoRow{ // S172
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		218, // Exp
-		104, // Term
-		105, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		101, // CallFunction
-		-1,  // CallFunctionAux
-		106, // Varcte
-		108, // ListElem
-		-1,  // Type
-		107, // Attribute
-	},
-	gotoRow{ // S173
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		219, // Exp
-		104, // Term
-		105, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		101, // CallFunction
-		-1,  // CallFunctionAux
-		106, // Varcte
-		108, // ListElem
-		-1,  // Type
-		107, // Attribute
-	},
-	gotoRow{ // S174
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		-1,  // Exp
-		220, // Term
-		105, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		101, // CallFunction
-		-1,  // CallFunctionAux
-		106, // Varcte
-		108, // ListElem
-		-1,  // Type
-		107, // Attribute
-	},
-	gotoRow{ // S175
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		-1,  // Exp
-		221, // Term
-		105, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		101, // CallFunction
-		-1,  // CallFunctionAux
-		106, // Varcte
-		108, // ListElem
-		-1,  // Type
-		107, // Attribute
-	},
-	gotoRow{ // S176
+	gotoRow{ // S692
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -6011,61 +34633,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S177
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		102, // Expression
-		-1,  // Operations
-		103, // Exp
-		104, // Term
-		105, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		101, // CallFunction
-		223, // CallFunctionAux
-		106, // Varcte
-		108, // ListElem
-		-1,  // Type
-		107, // Attribute
-	},
-	gotoRow{ // S178
+	gotoRow{ // S693
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
+		-1,  // GlobalVars
 		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
@@ -6077,63 +34681,45 @@ var gotoTab = gotoTable{
 		-1,  // BlockAux
 		-1,  // Statement
 		-1,  // BasicType
-		-1,  // Object
-		224, // Expression
-		-1,  // Operations
-		120, // Exp
-		121, // Term
-		122, // Factor
+		160, // Object
+		730, // Expression
+		167, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
 		-1,  // Assign
 		-1,  // Write
 		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
-		118, // CallFunction
+		163, // CallFunction
 		-1,  // CallFunctionAux
-		123, // Varcte
-		125, // ListElem
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
 		-1,  // Type
-		124, // Attribute
-	},
-	gotoRow{ // S179
-		-1, // S'
-		-1, // Programa
-		-1, // VarsOp
-		-1, // Vars
-		-1, // VarsDec
-		-1, // Ids
-		-1, // Params
-		-1, // ParamsAux
-		-1, // Functions
-		-1, // FunctionsAux
-		-1, // Block
-		-1, // BlockAux
-		-1, // Statement
-		-1, // BasicType
-		-1, // Object
-		-1, // Expression
-		-1, // Operations
-		-1, // Exp
-		-1, // Term
-		-1, // Factor
-		-1, // Assign
-		-1, // Write
-		-1, // Condition
-		-1, // Return
-		-1, // For
-		-1, // While
-		-1, // CallFunction
-		-1, // CallFunctionAux
-		-1, // Varcte
-		-1, // ListElem
-		-1, // Type
-		-1, // Attribute
+		-1,  // Dimensions
+		176, // Attribute
 	},
-	gotoRow{ // S180
+	gotoRow{ // S694
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -6147,27 +34733,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S181
+	gotoRow{ // S695
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -6181,27 +34783,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S182
+	gotoRow{ // S696
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
+		-1,  // GlobalVars
 		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
@@ -6213,29 +34831,45 @@ var gotoTab = gotoTable{
 		-1,  // BlockAux
 		-1,  // Statement
 		-1,  // BasicType
-		-1,  // Object
-		227, // Expression
-		-1,  // Operations
-		120, // Exp
-		121, // Term
-		122, // Factor
+		119, // Object
+		733, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
 		-1,  // Assign
 		-1,  // Write
 		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
-		118, // CallFunction
+		122, // CallFunction
 		-1,  // CallFunctionAux
-		123, // Varcte
-		125, // ListElem
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
 		-1,  // Type
-		124, // Attribute
+		-1,  // Dimensions
+		135, // Attribute
 	},
-	gotoRow{ // S183
+	gotoRow{ // S697
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
+		-1,  // GlobalVars
 		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
@@ -6247,29 +34881,95 @@ var gotoTab = gotoTable{
 		-1,  // BlockAux
 		-1,  // Statement
 		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		228, // Exp
-		121, // Term
-		122, // Factor
+		119, // Object
+		734, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
 		-1,  // Assign
 		-1,  // Write
 		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
-		118, // CallFunction
+		122, // CallFunction
 		-1,  // CallFunctionAux
-		123, // Varcte
-		125, // ListElem
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
 		-1,  // Type
-		124, // Attribute
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S698
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
 	},
-	gotoRow{ // S184
+	gotoRow{ // S699
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
+		-1,  // GlobalVars
 		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
@@ -6277,33 +34977,49 @@ var gotoTab = gotoTable{
 		-1,  // ParamsAux
 		-1,  // Functions
 		-1,  // FunctionsAux
-		-1,  // Block
+		735, // Block
 		-1,  // BlockAux
 		-1,  // Statement
 		-1,  // BasicType
 		-1,  // Object
 		-1,  // Expression
-		-1,  // Operations
-		229, // Exp
-		121, // Term
-		122, // Factor
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
 		-1,  // Assign
 		-1,  // Write
 		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
-		118, // CallFunction
+		-1,  // CallFunction
 		-1,  // CallFunctionAux
-		123, // Varcte
-		125, // ListElem
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
 		-1,  // Type
-		124, // Attribute
+		-1,  // Dimensions
+		-1,  // Attribute
 	},
-	gotoRow{ // S185
+	gotoRow{ // S700
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
+		-1,  // GlobalVars
 		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
@@ -6315,29 +35031,45 @@ var gotoTab = gotoTable{
 		-1,  // BlockAux
 		-1,  // Statement
 		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		-1,  // Exp
-		230, // Term
-		122, // Factor
+		119, // Object
+		736, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
 		-1,  // Assign
 		-1,  // Write
 		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
-		118, // CallFunction
+		122, // CallFunction
 		-1,  // CallFunctionAux
-		123, // Varcte
-		125, // ListElem
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
 		-1,  // Type
-		124, // Attribute
+		-1,  // Dimensions
+		135, // Attribute
 	},
-	gotoRow{ // S186
+	gotoRow{ // S701
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
+		-1,  // GlobalVars
 		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
@@ -6349,29 +35081,45 @@ var gotoTab = gotoTable{
 		-1,  // BlockAux
 		-1,  // Statement
 		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		-1,  // Exp
-		231, // Term
-		122, // Factor
+		119, // Object
+		737, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
 		-1,  // Assign
 		-1,  // Write
 		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
-		118, // CallFunction
+		122, // CallFunction
 		-1,  // CallFunctionAux
-		123, // Varcte
-		125, // ListElem
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
 		-1,  // Type
-		124, // Attribute
+		-1,  // Dimensions
+		135, // Attribute
 	},
-	gotoRow{ // S187
+	gotoRow{ // S702
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -6385,27 +35133,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S188
+	gotoRow{ // S703
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
+		-1,  // GlobalVars
 		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
@@ -6417,29 +35181,45 @@ var gotoTab = gotoTable{
 		-1,  // BlockAux
 		-1,  // Statement
 		-1,  // BasicType
-		-1,  // Object
-		102, // Expression
-		-1,  // Operations
-		103, // Exp
-		104, // Term
-		105, // Factor
+		119, // Object
+		738, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
 		-1,  // Assign
 		-1,  // Write
 		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
-		101, // CallFunction
-		233, // CallFunctionAux
-		106, // Varcte
-		108, // ListElem
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
 		-1,  // Type
-		107, // Attribute
+		-1,  // Dimensions
+		135, // Attribute
 	},
-	gotoRow{ // S189
+	gotoRow{ // S704
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
+		-1,  // GlobalVars
 		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
@@ -6451,29 +35231,45 @@ var gotoTab = gotoTable{
 		-1,  // BlockAux
 		-1,  // Statement
 		-1,  // BasicType
-		-1,  // Object
-		234, // Expression
-		-1,  // Operations
-		120, // Exp
-		121, // Term
-		122, // Factor
+		119, // Object
+		739, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
 		-1,  // Assign
 		-1,  // Write
 		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
-		118, // CallFunction
+		122, // CallFunction
 		-1,  // CallFunctionAux
-		123, // Varcte
-		125, // ListElem
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
 		-1,  // Type
-		124, // Attribute
+		-1,  // Dimensions
+		135, // Attribute
 	},
-	gotoRow{ // S190
+	gotoRow{ // S705
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -6487,27 +35283,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S191
+	gotoRow{ // S706
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -6521,27 +35333,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S192
+	gotoRow{ // S707
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -6555,231 +35383,143 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S193
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		238, // Expression
-		-1,  // Operations
-		139, // Exp
-		140, // Term
-		141, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		137, // CallFunction
-		-1,  // CallFunctionAux
-		142, // Varcte
-		144, // ListElem
-		-1,  // Type
-		143, // Attribute
-	},
-	gotoRow{ // S194
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		239, // Exp
-		140, // Term
-		141, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		137, // CallFunction
-		-1,  // CallFunctionAux
-		142, // Varcte
-		144, // ListElem
-		-1,  // Type
-		143, // Attribute
-	},
-	gotoRow{ // S195
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		240, // Exp
-		140, // Term
-		141, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		137, // CallFunction
-		-1,  // CallFunctionAux
-		142, // Varcte
-		144, // ListElem
-		-1,  // Type
-		143, // Attribute
-	},
-	gotoRow{ // S196
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		-1,  // Exp
-		241, // Term
-		141, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		137, // CallFunction
-		-1,  // CallFunctionAux
-		142, // Varcte
-		144, // ListElem
-		-1,  // Type
-		143, // Attribute
-	},
-	gotoRow{ // S197
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		-1,  // Exp
-		242, // Term
-		141, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		137, // CallFunction
-		-1,  // CallFunctionAux
-		142, // Varcte
-		144, // ListElem
-		-1,  // Type
-		143, // Attribute
+	gotoRow{ // S708
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
 	},
-	gotoRow{ // S198
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		244, // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		-1,  // Exp
-		-1,  // Term
-		-1,  // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		-1,  // CallFunction
-		-1,  // CallFunctionAux
-		-1,  // Varcte
-		-1,  // ListElem
-		-1,  // Type
-		-1,  // Attribute
+	gotoRow{ // S709
+		-1, // S'
+		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
+		-1, // VarsOp
+		-1, // GlobalVars
+		-1, // Vars
+		-1, // VarsDec
+		-1, // Ids
+		-1, // Params
+		-1, // ParamsAux
+		-1, // Functions
+		-1, // FunctionsAux
+		-1, // Block
+		-1, // BlockAux
+		-1, // Statement
+		-1, // BasicType
+		-1, // Object
+		-1, // Expression
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
+		-1, // Exp
+		-1, // Term
+		-1, // Factor
+		-1, // Assign
+		-1, // Write
+		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
+		-1, // Return
+		-1, // For
+		-1, // While
+		-1, // CallFunction
+		-1, // CallFunctionAux
+		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
+		-1, // ListElem
+		-1, // Indexes
+		-1, // Type
+		-1, // Dimensions
+		-1, // Attribute
 	},
-	gotoRow{ // S199
+	gotoRow{ // S710
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -6793,27 +35533,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S200
+	gotoRow{ // S711
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -6827,27 +35583,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S201
+	gotoRow{ // S712
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -6861,27 +35633,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S202
+	gotoRow{ // S713
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -6895,27 +35683,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S203
+	gotoRow{ // S714
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -6929,27 +35733,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S204
+	gotoRow{ // S715
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -6963,27 +35783,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S205
+	gotoRow{ // S716
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -6997,27 +35833,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S206
+	gotoRow{ // S717
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7031,27 +35883,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S207
+	gotoRow{ // S718
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7065,27 +35933,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S208
+	gotoRow{ // S719
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7099,95 +35983,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S209
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		247, // Expression
-		-1,  // Operations
-		85,  // Exp
-		86,  // Term
-		87,  // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		83,  // CallFunction
-		-1,  // CallFunctionAux
-		88,  // Varcte
-		90,  // ListElem
-		-1,  // Type
-		89,  // Attribute
-	},
-	gotoRow{ // S210
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		249, // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		-1,  // Exp
-		-1,  // Term
-		-1,  // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		-1,  // CallFunction
-		-1,  // CallFunctionAux
-		-1,  // Varcte
-		-1,  // ListElem
-		-1,  // Type
-		-1,  // Attribute
-	},
-	gotoRow{ // S211
+	gotoRow{ // S720
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7201,27 +36033,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S212
+	gotoRow{ // S721
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7235,27 +36083,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S213
+	gotoRow{ // S722
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7269,27 +36133,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S214
+	gotoRow{ // S723
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7303,27 +36183,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S215
+	gotoRow{ // S724
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7337,27 +36233,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S216
+	gotoRow{ // S725
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7371,27 +36283,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S217
+	gotoRow{ // S726
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7405,27 +36333,193 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S218
+	gotoRow{ // S727
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		750, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S728
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		751, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S729
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		752, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S730
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7439,27 +36533,143 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S219
+	gotoRow{ // S731
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		754, // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		492, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		491, // Attribute
+	},
+	gotoRow{ // S732
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		119, // Object
+		755, // Expression
+		126, // AndExp
+		127, // EqualityExp
+		128, // RelationalExp
+		129, // Exp
+		130, // Term
+		132, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		122, // CallFunction
+		-1,  // CallFunctionAux
+		133, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		136, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		135, // Attribute
+	},
+	gotoRow{ // S733
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7473,27 +36683,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S220
+	gotoRow{ // S734
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7507,27 +36733,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S221
+	gotoRow{ // S735
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7541,27 +36783,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S222
+	gotoRow{ // S736
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7575,27 +36833,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S223
+	gotoRow{ // S737
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7609,27 +36883,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S224
+	gotoRow{ // S738
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7643,27 +36933,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S225
+	gotoRow{ // S739
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7677,27 +36983,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S226
+	gotoRow{ // S740
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7711,27 +37033,143 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S227
+	gotoRow{ // S741
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		194, // Object
+		197, // Expression
+		202, // AndExp
+		203, // EqualityExp
+		204, // RelationalExp
+		205, // Exp
+		206, // Term
+		208, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		198, // CallFunction
+		757, // CallFunctionAux
+		209, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		212, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		211, // Attribute
+	},
+	gotoRow{ // S742
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		758, // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S743
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7745,27 +37183,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S228
+	gotoRow{ // S744
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7779,27 +37233,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S229
+	gotoRow{ // S745
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7813,27 +37283,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S230
+	gotoRow{ // S746
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7847,27 +37333,93 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S231
+	gotoRow{ // S747
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		759, // Expression
+		167, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S748
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7881,27 +37433,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S232
+	gotoRow{ // S749
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7915,27 +37483,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S233
+	gotoRow{ // S750
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7949,27 +37533,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S234
+	gotoRow{ // S751
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -7983,27 +37583,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S235
+	gotoRow{ // S752
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8017,27 +37633,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S236
+	gotoRow{ // S753
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8051,27 +37683,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S237
+	gotoRow{ // S754
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8085,27 +37733,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S238
+	gotoRow{ // S755
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8119,27 +37783,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S239
+	gotoRow{ // S756
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8153,27 +37833,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S240
+	gotoRow{ // S757
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8187,27 +37883,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S241
+	gotoRow{ // S758
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8221,27 +37933,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S242
+	gotoRow{ // S759
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8255,61 +37983,93 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S243
+	gotoRow{ // S760
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
-		48,  // Vars
-		50,  // VarsDec
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
 		-1,  // Ids
 		-1,  // Params
 		-1,  // ParamsAux
 		-1,  // Functions
 		-1,  // FunctionsAux
 		-1,  // Block
-		257, // BlockAux
-		52,  // Statement
-		9,   // BasicType
-		15,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		-1,  // Exp
-		-1,  // Term
-		-1,  // Factor
-		53,  // Assign
-		58,  // Write
-		54,  // Condition
-		55,  // Return
-		56,  // For
-		57,  // While
-		59,  // CallFunction
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		768, // Expression
+		167, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
 		-1,  // CallFunctionAux
-		-1,  // Varcte
-		61,  // ListElem
-		49,  // Type
-		60,  // Attribute
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
 	},
-	gotoRow{ // S244
+	gotoRow{ // S761
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8323,61 +38083,93 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S245
-		-1, // S'
-		-1, // Programa
-		-1, // VarsOp
-		-1, // Vars
-		-1, // VarsDec
-		-1, // Ids
-		-1, // Params
-		-1, // ParamsAux
-		-1, // Functions
-		-1, // FunctionsAux
-		-1, // Block
-		-1, // BlockAux
-		-1, // Statement
-		-1, // BasicType
-		-1, // Object
-		-1, // Expression
-		-1, // Operations
-		-1, // Exp
-		-1, // Term
-		-1, // Factor
-		-1, // Assign
-		-1, // Write
-		-1, // Condition
-		-1, // Return
-		-1, // For
-		-1, // While
-		-1, // CallFunction
-		-1, // CallFunctionAux
-		-1, // Varcte
-		-1, // ListElem
-		-1, // Type
-		-1, // Attribute
+	gotoRow{ // S762
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		771, // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
 	},
-	gotoRow{ // S246
+	gotoRow{ // S763
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8391,27 +38183,143 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S247
+	gotoRow{ // S764
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		160, // Object
+		773, // Expression
+		167, // AndExp
+		168, // EqualityExp
+		169, // RelationalExp
+		170, // Exp
+		171, // Term
+		173, // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		163, // CallFunction
+		-1,  // CallFunctionAux
+		174, // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		177, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		176, // Attribute
+	},
+	gotoRow{ // S765
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		775, // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S766
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8425,61 +38333,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S248
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		48,  // Vars
-		50,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		261, // BlockAux
-		52,  // Statement
-		9,   // BasicType
-		15,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		-1,  // Exp
-		-1,  // Term
-		-1,  // Factor
-		53,  // Assign
-		58,  // Write
-		54,  // Condition
-		55,  // Return
-		56,  // For
-		57,  // While
-		59,  // CallFunction
-		-1,  // CallFunctionAux
-		-1,  // Varcte
-		61,  // ListElem
-		49,  // Type
-		60,  // Attribute
-	},
-	gotoRow{ // S249
+	gotoRow{ // S767
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8493,27 +38383,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S250
+	gotoRow{ // S768
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8527,27 +38433,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S251
+	gotoRow{ // S769
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8561,27 +38483,93 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S252
+	gotoRow{ // S770
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		477, // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		778, // BlockAux
+		480, // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		481, // Assign
+		487, // Write
+		482, // Condition
+		483, // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		484, // Return
+		485, // For
+		486, // While
+		488, // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		492, // ListElem
+		-1,  // Indexes
+		476, // Type
+		-1,  // Dimensions
+		491, // Attribute
+	},
+	gotoRow{ // S771
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8595,27 +38583,93 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S253
+	gotoRow{ // S772
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		-1,  // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		780, // Cases
+		623, // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		-1,  // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		-1,  // Attribute
+	},
+	gotoRow{ // S773
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8629,27 +38683,93 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S254
+	gotoRow{ // S774
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		477, // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		783, // BlockAux
+		480, // Statement
+		21,  // BasicType
+		16,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		481, // Assign
+		487, // Write
+		482, // Condition
+		483, // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		484, // Return
+		485, // For
+		486, // While
+		488, // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		492, // ListElem
+		-1,  // Indexes
+		476, // Type
+		-1,  // Dimensions
+		491, // Attribute
+	},
+	gotoRow{ // S775
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8663,27 +38783,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S255
+	gotoRow{ // S776
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8697,27 +38833,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S256
+	gotoRow{ // S777
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8731,27 +38883,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S257
+	gotoRow{ // S778
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8765,27 +38933,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S258
+	gotoRow{ // S779
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
+		-1,  // GlobalVars
 		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
@@ -8793,67 +38977,49 @@ var gotoTab = gotoTable{
 		-1,  // ParamsAux
 		-1,  // Functions
 		-1,  // FunctionsAux
-		263, // Block
+		785, // Block
 		-1,  // BlockAux
 		-1,  // Statement
 		-1,  // BasicType
 		-1,  // Object
 		-1,  // Expression
-		-1,  // Operations
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
 		-1,  // Exp
 		-1,  // Term
 		-1,  // Factor
 		-1,  // Assign
 		-1,  // Write
-		-1,  // Condition
+		786, // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
 		-1,  // CallFunction
 		-1,  // CallFunctionAux
 		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
 		-1,  // ListElem
+		-1,  // Indexes
 		-1,  // Type
+		-1,  // Dimensions
 		-1,  // Attribute
 	},
-	gotoRow{ // S259
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		-1,  // Expression
-		-1,  // Operations
-		-1,  // Exp
-		-1,  // Term
-		-1,  // Factor
-		265, // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		-1,  // CallFunction
-		-1,  // CallFunctionAux
-		-1,  // Varcte
-		267, // ListElem
-		-1,  // Type
-		266, // Attribute
-	},
-	gotoRow{ // S260
+	gotoRow{ // S780
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8867,27 +39033,93 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S261
+	gotoRow{ // S781
+		-1,  // S'
+		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
+		-1,  // VarsOp
+		-1,  // GlobalVars
+		-1,  // Vars
+		-1,  // VarsDec
+		-1,  // Ids
+		-1,  // Params
+		-1,  // ParamsAux
+		-1,  // Functions
+		-1,  // FunctionsAux
+		-1,  // Block
+		-1,  // BlockAux
+		-1,  // Statement
+		-1,  // BasicType
+		-1,  // Object
+		-1,  // Expression
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
+		-1,  // Exp
+		-1,  // Term
+		-1,  // Factor
+		788, // Assign
+		-1,  // Write
+		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
+		-1,  // Return
+		-1,  // For
+		-1,  // While
+		-1,  // CallFunction
+		-1,  // CallFunctionAux
+		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
+		659, // ListElem
+		-1,  // Indexes
+		-1,  // Type
+		-1,  // Dimensions
+		658, // Attribute
+	},
+	gotoRow{ // S782
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8901,27 +39133,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S262
+	gotoRow{ // S783
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8935,27 +39183,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S263
+	gotoRow{ // S784
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -8969,27 +39233,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S264
+	gotoRow{ // S785
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -9003,27 +39283,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S265
+	gotoRow{ // S786
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -9037,27 +39333,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S266
+	gotoRow{ // S787
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -9071,27 +39383,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S267
+	gotoRow{ // S788
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -9105,27 +39433,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S268
+	gotoRow{ // S789
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -9139,61 +39483,43 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
-	gotoRow{ // S269
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		273, // Expression
-		-1,  // Operations
-		139, // Exp
-		140, // Term
-		141, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		137, // CallFunction
-		-1,  // CallFunctionAux
-		142, // Varcte
-		144, // ListElem
-		-1,  // Type
-		143, // Attribute
-	},
-	gotoRow{ // S270
+	gotoRow{ // S790
 		-1,  // S'
 		-1,  // Programa
+		-1,  // StructsOp
+		-1,  // Structs
+		-1,  // StructDec
+		-1,  // ClassMembers
+		-1,  // ClassMember
 		-1,  // VarsOp
+		-1,  // GlobalVars
 		-1,  // Vars
 		-1,  // VarsDec
 		-1,  // Ids
@@ -9201,203 +39527,49 @@ var gotoTab = gotoTable{
 		-1,  // ParamsAux
 		-1,  // Functions
 		-1,  // FunctionsAux
-		274, // Block
+		791, // Block
 		-1,  // BlockAux
 		-1,  // Statement
 		-1,  // BasicType
 		-1,  // Object
 		-1,  // Expression
-		-1,  // Operations
+		-1,  // AndExp
+		-1,  // EqualityExp
+		-1,  // RelationalExp
 		-1,  // Exp
 		-1,  // Term
 		-1,  // Factor
 		-1,  // Assign
 		-1,  // Write
 		-1,  // Condition
+		-1,  // Switch
+		-1,  // Cases
+		-1,  // Case
+		-1,  // CaseValues
 		-1,  // Return
 		-1,  // For
 		-1,  // While
 		-1,  // CallFunction
 		-1,  // CallFunctionAux
 		-1,  // Varcte
+		-1,  // FieldInits
+		-1,  // FieldInit
 		-1,  // ListElem
+		-1,  // Indexes
 		-1,  // Type
+		-1,  // Dimensions
 		-1,  // Attribute
 	},
-	gotoRow{ // S271
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		275, // Expression
-		-1,  // Operations
-		139, // Exp
-		140, // Term
-		141, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		137, // CallFunction
-		-1,  // CallFunctionAux
-		142, // Varcte
-		144, // ListElem
-		-1,  // Type
-		143, // Attribute
-	},
-	gotoRow{ // S272
-		-1,  // S'
-		-1,  // Programa
-		-1,  // VarsOp
-		-1,  // Vars
-		-1,  // VarsDec
-		-1,  // Ids
-		-1,  // Params
-		-1,  // ParamsAux
-		-1,  // Functions
-		-1,  // FunctionsAux
-		-1,  // Block
-		-1,  // BlockAux
-		-1,  // Statement
-		-1,  // BasicType
-		-1,  // Object
-		276, // Expression
-		-1,  // Operations
-		139, // Exp
-		140, // Term
-		141, // Factor
-		-1,  // Assign
-		-1,  // Write
-		-1,  // Condition
-		-1,  // Return
-		-1,  // For
-		-1,  // While
-		137, // CallFunction
-		-1,  // CallFunctionAux
-		142, // Varcte
-		144, // ListElem
-		-1,  // Type
-		143, // Attribute
-	},
-	gotoRow{ // S273
-		-1, // S'
-		-1, // Programa
-		-1, // VarsOp
-		-1, // Vars
-		-1, // VarsDec
-		-1, // Ids
-		-1, // Params
-		-1, // ParamsAux
-		-1, // Functions
-		-1, // FunctionsAux
-		-1, // Block
-		-1, // BlockAux
-		-1, // Statement
-		-1, // BasicType
-		-1, // Object
-		-1, // Expression
-		-1, // Operations
-		-1, // Exp
-		-1, // Term
-		-1, // Factor
-		-1, // Assign
-		-1, // Write
-		-1, // Condition
-		-1, // Return
-		-1, // For
-		-1, // While
-		-1, // CallFunction
-		-1, // CallFunctionAux
-		-1, // Varcte
-		-1, // ListElem
-		-1, // Type
-		-1, // Attribute
-	},
-	gotoRow{ // S274
-		-1, // S'
-		-1, // Programa
-		-1, // VarsOp
-		-1, // Vars
-		-1, // VarsDec
-		-1, // Ids
-		-1, // Params
-		-1, // ParamsAux
-		-1, // Functions
-		-1, // FunctionsAux
-		-1, // Block
-		-1, // BlockAux
-		-1, // Statement
-		-1, // BasicType
-		-1, // Object
-		-1, // Expression
-		-1, // Operations
-		-1, // Exp
-		-1, // Term
-		-1, // Factor
-		-1, // Assign
-		-1, // Write
-		-1, // Condition
-		-1, // Return
-		-1, // For
-		-1, // While
-		-1, // CallFunction
-		-1, // CallFunctionAux
-		-1, // Varcte
-		-1, // ListElem
-		-1, // Type
-		-1, // Attribute
-	},
-	gotoRow{ // S275
-		-1, // S'
-		-1, // Programa
-		-1, // VarsOp
-		-1, // Vars
-		-1, // VarsDec
-		-1, // Ids
-		-1, // Params
-		-1, // ParamsAux
-		-1, // Functions
-		-1, // FunctionsAux
-		-1, // Block
-		-1, // BlockAux
-		-1, // Statement
-		-1, // BasicType
-		-1, // Object
-		-1, // Expression
-		-1, // Operations
-		-1, // Exp
-		-1, // Term
-		-1, // Factor
-		-1, // Assign
-		-1, // Write
-		-1, // Condition
-		-1, // Return
-		-1, // For
-		-1, // While
-		-1, // CallFunction
-		-1, // CallFunctionAux
-		-1, // Varcte
-		-1, // ListElem
-		-1, // Type
-		-1, // Attribute
-	},
-	gotoRow{ // S276
+	gotoRow{ // S791
 		-1, // S'
 		-1, // Programa
+		-1, // StructsOp
+		-1, // Structs
+		-1, // StructDec
+		-1, // ClassMembers
+		-1, // ClassMember
 		-1, // VarsOp
+		-1, // GlobalVars
 		-1, // Vars
 		-1, // VarsDec
 		-1, // Ids
@@ -9411,21 +39583,31 @@ var gotoTab = gotoTable{
 		-1, // BasicType
 		-1, // Object
 		-1, // Expression
-		-1, // Operations
+		-1, // AndExp
+		-1, // EqualityExp
+		-1, // RelationalExp
 		-1, // Exp
 		-1, // Term
 		-1, // Factor
 		-1, // Assign
 		-1, // Write
 		-1, // Condition
+		-1, // Switch
+		-1, // Cases
+		-1, // Case
+		-1, // CaseValues
 		-1, // Return
 		-1, // For
 		-1, // While
 		-1, // CallFunction
 		-1, // CallFunctionAux
 		-1, // Varcte
+		-1, // FieldInits
+		-1, // FieldInit
 		-1, // ListElem
+		-1, // Indexes
 		-1, // Type
+		-1, // Dimensions
 		-1, // Attribute
 	},
 }
@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 78
-	numStates      = 277
-	numSymbols     = 77
+	numProductions = 139
+	numStates      = 792
+	numSymbols     = 107
 )
 
 // Stack
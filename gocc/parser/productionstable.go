@@ -33,18 +33,18 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `Programa : program id semicolon leftbracket VarsOp rightbracket Functions	<< ast.NewProgram(X[1], X[4], X[6]) >>`,
+		String: `Programa : program id semicolon StructsOp leftbracket VarsOp rightbracket Functions	<< ast.NewProgram(X[1], X[3], X[5], X[7]) >>`,
 		Id:         "Programa",
 		NTType:     1,
 		Index:      1,
-		NumSymbols: 7,
+		NumSymbols: 8,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewProgram(X[1], X[4], X[6])
+			return ast.NewProgram(X[1], X[3], X[5], X[7])
 		},
 	},
 	ProdTabEntry{
-		String: `VarsOp : Vars	<< (X[0]), nil >>`,
-		Id:         "VarsOp",
+		String: `StructsOp : Structs	<< (X[0]), nil >>`,
+		Id:         "StructsOp",
 		NTType:     2,
 		Index:      2,
 		NumSymbols: 1,
@@ -53,20 +53,160 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `VarsOp : empty	<< make([]*directories.VarEntry, 0), nil >>`,
-		Id:         "VarsOp",
+		String: `StructsOp : empty	<< make([]*ast.StructDec, 0), nil >>`,
+		Id:         "StructsOp",
 		NTType:     2,
 		Index:      3,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return make([]*directories.VarEntry, 0), nil
+			return make([]*ast.StructDec, 0), nil
 		},
 	},
 	ProdTabEntry{
-		String: `Vars : Type Ids semicolon Vars	<< ast.AppendVarsList(X[0], X[1], X[3]) >>`,
-		Id:         "Vars",
+		String: `Structs : StructDec Structs	<< ast.AppendStructList(X[0], X[1]) >>`,
+		Id:         "Structs",
 		NTType:     3,
 		Index:      4,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendStructList(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Structs : StructDec	<< ast.NewStructList(X[0]) >>`,
+		Id:         "Structs",
+		NTType:     3,
+		Index:      5,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewStructList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `StructDec : struct id leftbracket Vars rightbracket	<< ast.NewStructDec(X[1], X[3]) >>`,
+		Id:         "StructDec",
+		NTType:     4,
+		Index:      6,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewStructDec(X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `StructDec : class id leftbracket ClassMembers rightbracket	<< ast.NewClassDec(X[1], nil, X[3]) >>`,
+		Id:         "StructDec",
+		NTType:     4,
+		Index:      7,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewClassDec(X[1], nil, X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `StructDec : class id colon Object leftbracket ClassMembers rightbracket	<< ast.NewClassDec(X[1], X[3], X[5]) >>`,
+		Id:         "StructDec",
+		NTType:     4,
+		Index:      8,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewClassDec(X[1], X[3], X[5])
+		},
+	},
+	ProdTabEntry{
+		String: `ClassMembers : ClassMember ClassMembers	<< ast.AppendClassMember(X[0], X[1]) >>`,
+		Id:         "ClassMembers",
+		NTType:     5,
+		Index:      9,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendClassMember(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `ClassMembers : empty	<< ast.NewClassMembers() >>`,
+		Id:         "ClassMembers",
+		NTType:     5,
+		Index:      10,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewClassMembers()
+		},
+	},
+	ProdTabEntry{
+		String: `ClassMember : Type Ids semicolon	<< ast.NewVarsList(X[0], X[1]) >>`,
+		Id:         "ClassMember",
+		NTType:     6,
+		Index:      11,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewVarsList(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `ClassMember : Type id leftparenthesis Params rightparenthesis Block	<< ast.NewMethod(X[0], X[1], X[3], X[5]) >>`,
+		Id:         "ClassMember",
+		NTType:     6,
+		Index:      12,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewMethod(X[0], X[1], X[3], X[5])
+		},
+	},
+	ProdTabEntry{
+		String: `ClassMember : voidtype id leftparenthesis Params rightparenthesis Block	<< ast.NewMethod(X[0], X[1], X[3], X[5]) >>`,
+		Id:         "ClassMember",
+		NTType:     6,
+		Index:      13,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewMethod(X[0], X[1], X[3], X[5])
+		},
+	},
+	ProdTabEntry{
+		String: `VarsOp : GlobalVars	<< (X[0]), nil >>`,
+		Id:         "VarsOp",
+		NTType:     7,
+		Index:      14,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return (X[0]), nil
+		},
+	},
+	ProdTabEntry{
+		String: `VarsOp : empty	<< make([]*ast.Vars, 0), nil >>`,
+		Id:         "VarsOp",
+		NTType:     7,
+		Index:      15,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return make([]*ast.Vars, 0), nil
+		},
+	},
+	ProdTabEntry{
+		String: `GlobalVars : VarsDec GlobalVars	<< ast.AppendVarsDecList(X[0], X[1]) >>`,
+		Id:         "GlobalVars",
+		NTType:     8,
+		Index:      16,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendVarsDecList(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `GlobalVars : VarsDec	<< ast.NewVarsDecList(X[0]) >>`,
+		Id:         "GlobalVars",
+		NTType:     8,
+		Index:      17,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewVarsDecList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Vars : Type Ids semicolon Vars	<< ast.AppendVarsList(X[0], X[1], X[3]) >>`,
+		Id:         "Vars",
+		NTType:     9,
+		Index:      18,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.AppendVarsList(X[0], X[1], X[3])
@@ -75,28 +215,48 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Vars : Type Ids semicolon	<< ast.NewVarsList(X[0], X[1]) >>`,
 		Id:         "Vars",
-		NTType:     3,
-		Index:      5,
+		NTType:     9,
+		Index:      19,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewVarsList(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `VarsDec : Vars	<< ast.NewVarsDec(X[0]) >>`,
+		String: `VarsDec : Type Ids semicolon	<< ast.NewVarsDec(X[0], X[1]) >>`,
 		Id:         "VarsDec",
-		NTType:     4,
-		Index:      6,
-		NumSymbols: 1,
+		NTType:     10,
+		Index:      20,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewVarsDec(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `VarsDec : Type id equals Expression semicolon	<< ast.NewVarsDecInit(X[0], X[1], X[3]) >>`,
+		Id:         "VarsDec",
+		NTType:     10,
+		Index:      21,
+		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewVarsDec(X[0])
+			return ast.NewVarsDecInit(X[0], X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `VarsDec : const Type id equals Expression semicolon	<< ast.NewConstantDec(X[1], X[2], X[4]) >>`,
+		Id:         "VarsDec",
+		NTType:     10,
+		Index:      22,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewConstantDec(X[1], X[2], X[4])
 		},
 	},
 	ProdTabEntry{
 		String: `Ids : id comma Ids	<< ast.AppendIdList(X[0], X[2]) >>`,
 		Id:         "Ids",
-		NTType:     5,
-		Index:      7,
+		NTType:     11,
+		Index:      23,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.AppendIdList(X[0], X[2])
@@ -105,8 +265,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Ids : id	<< ast.NewIdList(X[0]) >>`,
 		Id:         "Ids",
-		NTType:     5,
-		Index:      8,
+		NTType:     11,
+		Index:      24,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewIdList(X[0])
@@ -115,8 +275,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Params : ParamsAux	<< (X[0]), nil >>`,
 		Id:         "Params",
-		NTType:     6,
-		Index:      9,
+		NTType:     12,
+		Index:      25,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return (X[0]), nil
@@ -125,8 +285,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Params : empty	<< make([]*directories.VarEntry, 0), nil >>`,
 		Id:         "Params",
-		NTType:     6,
-		Index:      10,
+		NTType:     12,
+		Index:      26,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return make([]*directories.VarEntry, 0), nil
@@ -135,8 +295,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ParamsAux : Type id comma ParamsAux	<< ast.AppendParamsList(X[0], X[1], X[3]) >>`,
 		Id:         "ParamsAux",
-		NTType:     7,
-		Index:      11,
+		NTType:     13,
+		Index:      27,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.AppendParamsList(X[0], X[1], X[3])
@@ -145,8 +305,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ParamsAux : Type id	<< ast.NewParamsList(X[0], X[1]) >>`,
 		Id:         "ParamsAux",
-		NTType:     7,
-		Index:      12,
+		NTType:     13,
+		Index:      28,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewParamsList(X[0], X[1])
@@ -155,8 +315,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Functions : FunctionsAux id leftparenthesis Params rightparenthesis Block Functions	<< ast.AppendFunction(X[0], X[1], X[3], X[5], X[6]) >>`,
 		Id:         "Functions",
-		NTType:     8,
-		Index:      13,
+		NTType:     14,
+		Index:      29,
 		NumSymbols: 7,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.AppendFunction(X[0], X[1], X[3], X[5], X[6])
@@ -165,8 +325,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Functions : FunctionsAux id leftparenthesis Params rightparenthesis Block	<< ast.FirstFunction(X[0], X[1], X[3], X[5]) >>`,
 		Id:         "Functions",
-		NTType:     8,
-		Index:      14,
+		NTType:     14,
+		Index:      30,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.FirstFunction(X[0], X[1], X[3], X[5])
@@ -175,8 +335,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FunctionsAux : Type	<< X[0], nil >>`,
 		Id:         "FunctionsAux",
-		NTType:     9,
-		Index:      15,
+		NTType:     15,
+		Index:      31,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -185,8 +345,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FunctionsAux : voidtype	<< ast.NewType(X[0]) >>`,
 		Id:         "FunctionsAux",
-		NTType:     9,
-		Index:      16,
+		NTType:     15,
+		Index:      32,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewType(X[0])
@@ -195,8 +355,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Block : leftbracket BlockAux rightbracket	<< (X[1]), nil >>`,
 		Id:         "Block",
-		NTType:     10,
-		Index:      17,
+		NTType:     16,
+		Index:      33,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return (X[1]), nil
@@ -205,8 +365,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Block : leftbracket rightbracket	<< make([]ast.Statement, 0), nil >>`,
 		Id:         "Block",
-		NTType:     10,
-		Index:      18,
+		NTType:     16,
+		Index:      34,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return make([]ast.Statement, 0), nil
@@ -215,8 +375,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `BlockAux : Statement	<< ast.NewStatementList(X[0]) >>`,
 		Id:         "BlockAux",
-		NTType:     11,
-		Index:      19,
+		NTType:     17,
+		Index:      35,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewStatementList(X[0])
@@ -225,8 +385,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `BlockAux : Statement BlockAux	<< ast.AppendStatementList(X[0], X[1]) >>`,
 		Id:         "BlockAux",
-		NTType:     11,
-		Index:      20,
+		NTType:     17,
+		Index:      36,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.AppendStatementList(X[0], X[1])
@@ -235,8 +395,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Statement : VarsDec	<< ast.NewStatement(X[0]) >>`,
 		Id:         "Statement",
-		NTType:     12,
-		Index:      21,
+		NTType:     18,
+		Index:      37,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewStatement(X[0])
@@ -245,8 +405,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Statement : Assign semicolon	<< ast.NewStatement(X[0]) >>`,
 		Id:         "Statement",
-		NTType:     12,
-		Index:      22,
+		NTType:     18,
+		Index:      38,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewStatement(X[0])
@@ -255,8 +415,18 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Statement : Condition	<< ast.NewStatement(X[0]) >>`,
 		Id:         "Statement",
-		NTType:     12,
-		Index:      23,
+		NTType:     18,
+		Index:      39,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewStatement(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : Switch	<< ast.NewStatement(X[0]) >>`,
+		Id:         "Statement",
+		NTType:     18,
+		Index:      40,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewStatement(X[0])
@@ -265,8 +435,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Statement : Return	<< ast.NewStatement(X[0]) >>`,
 		Id:         "Statement",
-		NTType:     12,
-		Index:      24,
+		NTType:     18,
+		Index:      41,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewStatement(X[0])
@@ -275,8 +445,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Statement : For	<< ast.NewStatement(X[0]) >>`,
 		Id:         "Statement",
-		NTType:     12,
-		Index:      25,
+		NTType:     18,
+		Index:      42,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewStatement(X[0])
@@ -285,8 +455,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Statement : While	<< ast.NewStatement(X[0]) >>`,
 		Id:         "Statement",
-		NTType:     12,
-		Index:      26,
+		NTType:     18,
+		Index:      43,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewStatement(X[0])
@@ -295,8 +465,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Statement : Write	<< ast.NewStatement(X[0]) >>`,
 		Id:         "Statement",
-		NTType:     12,
-		Index:      27,
+		NTType:     18,
+		Index:      44,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewStatement(X[0])
@@ -305,18 +475,38 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Statement : CallFunction semicolon	<< ast.NewStatement(X[0]) >>`,
 		Id:         "Statement",
-		NTType:     12,
-		Index:      28,
+		NTType:     18,
+		Index:      45,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewStatement(X[0])
 		},
 	},
+	ProdTabEntry{
+		String: `Statement : break semicolon	<< ast.NewBreak(X[0]) >>`,
+		Id:         "Statement",
+		NTType:     18,
+		Index:      46,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewBreak(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : continue semicolon	<< ast.NewContinue(X[0]) >>`,
+		Id:         "Statement",
+		NTType:     18,
+		Index:      47,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewContinue(X[0])
+		},
+	},
 	ProdTabEntry{
 		String: `BasicType : inttype	<< ast.NewType(X[0]) >>`,
 		Id:         "BasicType",
-		NTType:     13,
-		Index:      29,
+		NTType:     19,
+		Index:      48,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewType(X[0])
@@ -325,9 +515,9 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `BasicType : floattype	<< ast.NewType(X[0]) >>`,
 		Id:         "BasicType",
-		NTType:     13,
-		Index:      30,
-		NumSymbols: 1,
+		NTType:     19,
+		Index:      49,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewType(X[0])
 		},
@@ -335,8 +525,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `BasicType : booltype	<< ast.NewType(X[0]) >>`,
 		Id:         "BasicType",
-		NTType:     13,
-		Index:      31,
+		NTType:     19,
+		Index:      50,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewType(X[0])
@@ -345,8 +535,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `BasicType : stringtype	<< ast.NewType(X[0]) >>`,
 		Id:         "BasicType",
-		NTType:     13,
-		Index:      32,
+		NTType:     19,
+		Index:      51,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewType(X[0])
@@ -355,8 +545,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `BasicType : chartype	<< ast.NewType(X[0]) >>`,
 		Id:         "BasicType",
-		NTType:     13,
-		Index:      33,
+		NTType:     19,
+		Index:      52,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewType(X[0])
@@ -365,8 +555,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `BasicType : Object	<< (X[0]), nil >>`,
 		Id:         "BasicType",
-		NTType:     13,
-		Index:      34,
+		NTType:     19,
+		Index:      53,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return (X[0]), nil
@@ -375,8 +565,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Object : squaretype	<< ast.NewType(X[0]) >>`,
 		Id:         "Object",
-		NTType:     14,
-		Index:      35,
+		NTType:     20,
+		Index:      54,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewType(X[0])
@@ -385,8 +575,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Object : circletype	<< ast.NewType(X[0]) >>`,
 		Id:         "Object",
-		NTType:     14,
-		Index:      36,
+		NTType:     20,
+		Index:      55,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewType(X[0])
@@ -395,8 +585,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Object : imagetype	<< ast.NewType(X[0]) >>`,
 		Id:         "Object",
-		NTType:     14,
-		Index:      37,
+		NTType:     20,
+		Index:      56,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewType(X[0])
@@ -405,8 +595,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Object : texttype	<< ast.NewType(X[0]) >>`,
 		Id:         "Object",
-		NTType:     14,
-		Index:      38,
+		NTType:     20,
+		Index:      57,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewType(X[0])
@@ -415,118 +605,168 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Object : backgroundtype	<< ast.NewType(X[0]) >>`,
 		Id:         "Object",
-		NTType:     14,
-		Index:      39,
+		NTType:     20,
+		Index:      58,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewType(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `Expression : Exp	<< ast.NewExpression(X[0]) >>`,
+		String: `Expression : AndExp	<< ast.NewExpression(X[0]) >>`,
 		Id:         "Expression",
-		NTType:     15,
-		Index:      40,
+		NTType:     21,
+		Index:      59,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewExpression(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `Expression : Exp Operations Expression	<< ast.AppendExpression(X[0], X[1], X[2]) >>`,
+		String: `Expression : Expression orop AndExp	<< ast.AppendExpression(X[0], X[1], X[2]) >>`,
 		Id:         "Expression",
-		NTType:     15,
-		Index:      41,
+		NTType:     21,
+		Index:      60,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.AppendExpression(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Operations : relop	<<  >>`,
-		Id:         "Operations",
-		NTType:     16,
-		Index:      42,
+		String: `AndExp : EqualityExp	<< ast.NewAndExp(X[0]) >>`,
+		Id:         "AndExp",
+		NTType:     22,
+		Index:      61,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return ast.NewAndExp(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `Operations : logicalop	<<  >>`,
-		Id:         "Operations",
-		NTType:     16,
-		Index:      43,
+		String: `AndExp : AndExp andop EqualityExp	<< ast.AppendAndExp(X[0], X[1], X[2]) >>`,
+		Id:         "AndExp",
+		NTType:     22,
+		Index:      62,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendAndExp(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `EqualityExp : RelationalExp	<< ast.NewEqualityExp(X[0]) >>`,
+		Id:         "EqualityExp",
+		NTType:     23,
+		Index:      63,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return X[0], nil
+			return ast.NewEqualityExp(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `EqualityExp : EqualityExp eqop RelationalExp	<< ast.AppendEqualityExp(X[0], X[1], X[2]) >>`,
+		Id:         "EqualityExp",
+		NTType:     23,
+		Index:      64,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendEqualityExp(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `RelationalExp : Exp	<< ast.NewRelationalExp(X[0]) >>`,
+		Id:         "RelationalExp",
+		NTType:     24,
+		Index:      65,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewRelationalExp(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `RelationalExp : RelationalExp relop Exp	<< ast.AppendRelationalExp(X[0], X[1], X[2]) >>`,
+		Id:         "RelationalExp",
+		NTType:     24,
+		Index:      66,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendRelationalExp(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `Exp : Term	<< ast.NewExp(X[0]) >>`,
 		Id:         "Exp",
-		NTType:     17,
-		Index:      44,
+		NTType:     25,
+		Index:      67,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewExp(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `Exp : Term plus Exp	<< ast.AppendExp(X[0],X[1],X[2]) >>`,
+		String: `Exp : Exp plus Term	<< ast.AppendExp(X[0], X[1], X[2]) >>`,
 		Id:         "Exp",
-		NTType:     17,
-		Index:      45,
+		NTType:     25,
+		Index:      68,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.AppendExp(X[0],X[1],X[2])
+			return ast.AppendExp(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Exp : Term minus Exp	<< ast.AppendExp(X[0],X[1],X[2]) >>`,
+		String: `Exp : Exp minus Term	<< ast.AppendExp(X[0], X[1], X[2]) >>`,
 		Id:         "Exp",
-		NTType:     17,
-		Index:      46,
+		NTType:     25,
+		Index:      69,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.AppendExp(X[0],X[1],X[2])
+			return ast.AppendExp(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `Term : Factor	<< ast.NewTerm(X[0]) >>`,
 		Id:         "Term",
-		NTType:     18,
-		Index:      47,
+		NTType:     26,
+		Index:      70,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewTerm(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `Term : Factor mult Term	<< ast.AppendTerm(X[0],X[1],X[2]) >>`,
+		String: `Term : Term mult Factor	<< ast.AppendTerm(X[0], X[1], X[2]) >>`,
 		Id:         "Term",
-		NTType:     18,
-		Index:      48,
+		NTType:     26,
+		Index:      71,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.AppendTerm(X[0],X[1],X[2])
+			return ast.AppendTerm(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Term : Factor div Term	<< ast.AppendTerm(X[0],X[1],X[2]) >>`,
+		String: `Term : Term div Factor	<< ast.AppendTerm(X[0], X[1], X[2]) >>`,
 		Id:         "Term",
-		NTType:     18,
-		Index:      49,
+		NTType:     26,
+		Index:      72,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.AppendTerm(X[0],X[1],X[2])
+			return ast.AppendTerm(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Term : Term mod Factor	<< ast.AppendTerm(X[0], X[1], X[2]) >>`,
+		Id:         "Term",
+		NTType:     26,
+		Index:      73,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendTerm(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `Factor : leftparenthesis Expression rightparenthesis	<< ast.NewFactor(X[1]) >>`,
 		Id:         "Factor",
-		NTType:     19,
-		Index:      50,
+		NTType:     27,
+		Index:      74,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewFactor(X[1])
@@ -535,18 +775,38 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Factor : Varcte	<< ast.NewVCFactor(X[0]) >>`,
 		Id:         "Factor",
-		NTType:     19,
-		Index:      51,
+		NTType:     27,
+		Index:      75,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewVCFactor(X[0])
 		},
 	},
+	ProdTabEntry{
+		String: `Factor : not Factor	<< ast.NewUnaryFactor(X[0], X[1]) >>`,
+		Id:         "Factor",
+		NTType:     27,
+		Index:      76,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewUnaryFactor(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Factor : minus Factor	<< ast.NewUnaryFactor(X[0], X[1]) >>`,
+		Id:         "Factor",
+		NTType:     27,
+		Index:      77,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewUnaryFactor(X[0], X[1])
+		},
+	},
 	ProdTabEntry{
 		String: `Assign : id equals Expression	<< ast.NewAssignWithoutAttr(X[0], X[2]) >>`,
 		Id:         "Assign",
-		NTType:     20,
-		Index:      52,
+		NTType:     28,
+		Index:      78,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewAssignWithoutAttr(X[0], X[2])
@@ -555,8 +815,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Assign : Attribute equals Expression	<< ast.NewAssignWithAttr(X[0], X[2]) >>`,
 		Id:         "Assign",
-		NTType:     20,
-		Index:      53,
+		NTType:     28,
+		Index:      79,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewAssignWithAttr(X[0], X[2])
@@ -565,18 +825,78 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Assign : ListElem equals Expression	<< ast.NewAssignWithIndex(X[0], X[2]) >>`,
 		Id:         "Assign",
-		NTType:     20,
-		Index:      54,
+		NTType:     28,
+		Index:      80,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewAssignWithIndex(X[0], X[2])
 		},
 	},
+	ProdTabEntry{
+		String: `Assign : id assignop Expression	<< ast.NewCompoundAssign(X[0], X[1], X[2]) >>`,
+		Id:         "Assign",
+		NTType:     28,
+		Index:      81,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewCompoundAssign(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Assign : Attribute assignop Expression	<< ast.NewCompoundAssign(X[0], X[1], X[2]) >>`,
+		Id:         "Assign",
+		NTType:     28,
+		Index:      82,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewCompoundAssign(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Assign : ListElem assignop Expression	<< ast.NewCompoundAssign(X[0], X[1], X[2]) >>`,
+		Id:         "Assign",
+		NTType:     28,
+		Index:      83,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewCompoundAssign(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Assign : id incop	<< ast.NewIncrement(X[0], X[1]) >>`,
+		Id:         "Assign",
+		NTType:     28,
+		Index:      84,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewIncrement(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Assign : Attribute incop	<< ast.NewIncrement(X[0], X[1]) >>`,
+		Id:         "Assign",
+		NTType:     28,
+		Index:      85,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewIncrement(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Assign : ListElem incop	<< ast.NewIncrement(X[0], X[1]) >>`,
+		Id:         "Assign",
+		NTType:     28,
+		Index:      86,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewIncrement(X[0], X[1])
+		},
+	},
 	ProdTabEntry{
 		String: `Write : print leftparenthesis Expression rightparenthesis semicolon	<< ast.NewWrite(X[0], X[2]) >>`,
 		Id:         "Write",
-		NTType:     21,
-		Index:      55,
+		NTType:     29,
+		Index:      87,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewWrite(X[0], X[2])
@@ -585,8 +905,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Condition : if leftparenthesis Expression rightparenthesis Block	<< ast.NewConditionNoElseStmts(X[0], X[2], X[4]) >>`,
 		Id:         "Condition",
-		NTType:     22,
-		Index:      56,
+		NTType:     30,
+		Index:      88,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewConditionNoElseStmts(X[0], X[2], X[4])
@@ -595,18 +915,118 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Condition : if leftparenthesis Expression rightparenthesis Block else Block	<< ast.NewCondition(X[0], X[2], X[4], X[6] ) >>`,
 		Id:         "Condition",
-		NTType:     22,
-		Index:      57,
+		NTType:     30,
+		Index:      89,
 		NumSymbols: 7,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewCondition(X[0], X[2], X[4], X[6] )
 		},
 	},
+	ProdTabEntry{
+		String: `Condition : if leftparenthesis Expression rightparenthesis Block else Condition	<< ast.NewConditionElseIf(X[0], X[2], X[4], X[6]) >>`,
+		Id:         "Condition",
+		NTType:     30,
+		Index:      90,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewConditionElseIf(X[0], X[2], X[4], X[6])
+		},
+	},
+	ProdTabEntry{
+		String: `Switch : switch leftparenthesis Expression rightparenthesis leftbracket Cases rightbracket	<< ast.NewSwitch(X[0], X[2], X[5]) >>`,
+		Id:         "Switch",
+		NTType:     31,
+		Index:      91,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewSwitch(X[0], X[2], X[5])
+		},
+	},
+	ProdTabEntry{
+		String: `Cases : Case	<< ast.NewCaseList(X[0]) >>`,
+		Id:         "Cases",
+		NTType:     32,
+		Index:      92,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewCaseList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Cases : Case Cases	<< ast.AppendCaseList(X[0], X[1]) >>`,
+		Id:         "Cases",
+		NTType:     32,
+		Index:      93,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendCaseList(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Case : case CaseValues colon BlockAux	<< ast.NewCase(X[0], X[1], X[3]) >>`,
+		Id:         "Case",
+		NTType:     33,
+		Index:      94,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewCase(X[0], X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Case : case CaseValues colon	<< ast.NewCase(X[0], X[1], make([]ast.Statement, 0)) >>`,
+		Id:         "Case",
+		NTType:     33,
+		Index:      95,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewCase(X[0], X[1], make([]ast.Statement, 0))
+		},
+	},
+	ProdTabEntry{
+		String: `Case : default colon BlockAux	<< ast.NewDefaultCase(X[0], X[2]) >>`,
+		Id:         "Case",
+		NTType:     33,
+		Index:      96,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewDefaultCase(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Case : default colon	<< ast.NewDefaultCase(X[0], make([]ast.Statement, 0)) >>`,
+		Id:         "Case",
+		NTType:     33,
+		Index:      97,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewDefaultCase(X[0], make([]ast.Statement, 0))
+		},
+	},
+	ProdTabEntry{
+		String: `CaseValues : Factor	<< ast.NewCaseValues(X[0]) >>`,
+		Id:         "CaseValues",
+		NTType:     34,
+		Index:      98,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewCaseValues(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `CaseValues : Factor comma CaseValues	<< ast.AppendCaseValues(X[0], X[2]) >>`,
+		Id:         "CaseValues",
+		NTType:     34,
+		Index:      99,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendCaseValues(X[0], X[2])
+		},
+	},
 	ProdTabEntry{
 		String: `Return : return Expression semicolon	<< ast.NewReturn(X[0], X[1]) >>`,
 		Id:         "Return",
-		NTType:     23,
-		Index:      58,
+		NTType:     35,
+		Index:      100,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewReturn(X[0], X[1])
@@ -615,8 +1035,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `For : for leftparenthesis Assign semicolon Expression semicolon Assign rightparenthesis Block	<< ast.NewFor(X[0], X[2], X[4], X[6], X[8]) >>`,
 		Id:         "For",
-		NTType:     24,
-		Index:      59,
+		NTType:     36,
+		Index:      101,
 		NumSymbols: 9,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewFor(X[0], X[2], X[4], X[6], X[8])
@@ -625,8 +1045,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `While : while leftparenthesis Expression rightparenthesis Block	<< ast.NewWhile(X[0], X[2], X[4]) >>`,
 		Id:         "While",
-		NTType:     25,
-		Index:      60,
+		NTType:     37,
+		Index:      102,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewWhile(X[0], X[2], X[4])
@@ -635,8 +1055,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `CallFunction : id leftparenthesis CallFunctionAux rightparenthesis	<< ast.NewFunctionCall(X[0], X[2]) >>`,
 		Id:         "CallFunction",
-		NTType:     26,
-		Index:      61,
+		NTType:     38,
+		Index:      103,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewFunctionCall(X[0], X[2])
@@ -645,18 +1065,38 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `CallFunction : id leftparenthesis rightparenthesis	<< ast.NewFunctionCallId(X[0]) >>`,
 		Id:         "CallFunction",
-		NTType:     26,
-		Index:      62,
+		NTType:     38,
+		Index:      104,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewFunctionCallId(X[0])
 		},
 	},
+	ProdTabEntry{
+		String: `CallFunction : id dot id leftparenthesis CallFunctionAux rightparenthesis	<< ast.NewClassFunctionCall(X[0], X[2], X[4]) >>`,
+		Id:         "CallFunction",
+		NTType:     38,
+		Index:      105,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewClassFunctionCall(X[0], X[2], X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `CallFunction : id dot id leftparenthesis rightparenthesis	<< ast.NewClassFunctionCall(X[0], X[2], make([]*ast.Expression, 0)) >>`,
+		Id:         "CallFunction",
+		NTType:     38,
+		Index:      106,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewClassFunctionCall(X[0], X[2], make([]*ast.Expression, 0))
+		},
+	},
 	ProdTabEntry{
 		String: `CallFunctionAux : Expression	<< ast.NewArgumentExpression(X[0]) >>`,
 		Id:         "CallFunctionAux",
-		NTType:     27,
-		Index:      63,
+		NTType:     39,
+		Index:      107,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewArgumentExpression(X[0])
@@ -665,8 +1105,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `CallFunctionAux : Expression comma CallFunctionAux	<< ast.AppendArgumentExpression(X[0], X[2]) >>`,
 		Id:         "CallFunctionAux",
-		NTType:     27,
-		Index:      64,
+		NTType:     39,
+		Index:      108,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.AppendArgumentExpression(X[0], X[2])
@@ -675,8 +1115,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Varcte : id	<< ast.NewAttribute(X[0], &token.Token{Lit: []byte("")}) >>`,
 		Id:         "Varcte",
-		NTType:     28,
-		Index:      65,
+		NTType:     40,
+		Index:      109,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewAttribute(X[0], &token.Token{Lit: []byte("")})
@@ -685,8 +1125,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Varcte : cteint	<< ast.NewConstantInt(X[0]) >>`,
 		Id:         "Varcte",
-		NTType:     28,
-		Index:      66,
+		NTType:     40,
+		Index:      110,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewConstantInt(X[0])
@@ -695,8 +1135,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Varcte : ctefloat	<< ast.NewConstantFloat(X[0]) >>`,
 		Id:         "Varcte",
-		NTType:     28,
-		Index:      67,
+		NTType:     40,
+		Index:      111,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewConstantFloat(X[0])
@@ -705,8 +1145,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Varcte : ctestring	<< ast.NewConstantString(X[0]) >>`,
 		Id:         "Varcte",
-		NTType:     28,
-		Index:      68,
+		NTType:     40,
+		Index:      112,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewConstantString(X[0])
@@ -715,8 +1155,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Varcte : ctechar	<< ast.NewConstantChar(X[0]) >>`,
 		Id:         "Varcte",
-		NTType:     28,
-		Index:      69,
+		NTType:     40,
+		Index:      113,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewConstantChar(X[0])
@@ -725,8 +1165,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Varcte : ctebool	<< ast.NewConstantBool(X[0]) >>`,
 		Id:         "Varcte",
-		NTType:     28,
-		Index:      70,
+		NTType:     40,
+		Index:      114,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewConstantBool(X[0])
@@ -735,8 +1175,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Varcte : ListElem	<< X[0], nil >>`,
 		Id:         "Varcte",
-		NTType:     28,
-		Index:      71,
+		NTType:     40,
+		Index:      115,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -745,8 +1185,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Varcte : Attribute	<< X[0], nil >>`,
 		Id:         "Varcte",
-		NTType:     28,
-		Index:      72,
+		NTType:     40,
+		Index:      116,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -755,51 +1195,221 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Varcte : CallFunction	<< X[0], nil >>`,
 		Id:         "Varcte",
-		NTType:     28,
-		Index:      73,
+		NTType:     40,
+		Index:      117,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `ListElem : id leftsqrbracket Expression rightsqrbracket	<< ast.NewListElem(X[0], X[2]) >>`,
+		String: `Varcte : inttype leftparenthesis Expression rightparenthesis	<< ast.NewCast(X[0], X[2]) >>`,
+		Id:         "Varcte",
+		NTType:     40,
+		Index:      118,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewCast(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Varcte : floattype leftparenthesis Expression rightparenthesis	<< ast.NewCast(X[0], X[2]) >>`,
+		Id:         "Varcte",
+		NTType:     40,
+		Index:      119,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewCast(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Varcte : chartype leftparenthesis Expression rightparenthesis	<< ast.NewCast(X[0], X[2]) >>`,
+		Id:         "Varcte",
+		NTType:     40,
+		Index:      120,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewCast(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Varcte : Object leftbracket FieldInits rightbracket	<< ast.NewObjectLiteral(X[0], X[1], X[2]) >>`,
+		Id:         "Varcte",
+		NTType:     40,
+		Index:      121,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewObjectLiteral(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Varcte : Object leftbracket rightbracket	<< ast.NewObjectLiteral(X[0], X[1], make([]*ast.FieldInit, 0)) >>`,
+		Id:         "Varcte",
+		NTType:     40,
+		Index:      122,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewObjectLiteral(X[0], X[1], make([]*ast.FieldInit, 0))
+		},
+	},
+	ProdTabEntry{
+		String: `FieldInits : FieldInit comma FieldInits	<< ast.AppendFieldInitList(X[0], X[2]) >>`,
+		Id:         "FieldInits",
+		NTType:     41,
+		Index:      123,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendFieldInitList(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `FieldInits : FieldInit	<< ast.NewFieldInitList(X[0]) >>`,
+		Id:         "FieldInits",
+		NTType:     41,
+		Index:      124,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewFieldInitList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `FieldInit : id colon Expression	<< ast.NewFieldInit(X[0], X[2]) >>`,
+		Id:         "FieldInit",
+		NTType:     42,
+		Index:      125,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewFieldInit(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `ListElem : id Indexes	<< ast.NewListElem(X[0], X[1]) >>`,
 		Id:         "ListElem",
-		NTType:     29,
-		Index:      74,
+		NTType:     43,
+		Index:      126,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewListElem(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Indexes : leftsqrbracket Expression rightsqrbracket Indexes	<< ast.AppendIndexList(X[1], X[3]) >>`,
+		Id:         "Indexes",
+		NTType:     44,
+		Index:      127,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewListElem(X[0], X[2])
+			return ast.AppendIndexList(X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Indexes : leftsqrbracket Expression rightsqrbracket	<< ast.NewIndexList(X[1]) >>`,
+		Id:         "Indexes",
+		NTType:     44,
+		Index:      128,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewIndexList(X[1])
 		},
 	},
 	ProdTabEntry{
 		String: `Type : BasicType	<< (X[0]), nil >>`,
 		Id:         "Type",
-		NTType:     30,
-		Index:      75,
+		NTType:     45,
+		Index:      129,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return (X[0]), nil
 		},
 	},
 	ProdTabEntry{
-		String: `Type : BasicType leftsqrbracket cteint rightsqrbracket	<< ast.NewTypeArray(X[0], X[2]) >>`,
+		String: `Type : BasicType Dimensions	<< ast.NewTypeArray(X[0], X[1]) >>`,
 		Id:         "Type",
-		NTType:     30,
-		Index:      76,
+		NTType:     45,
+		Index:      130,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewTypeArray(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Type : id	<< ast.NewStructType(X[0]) >>`,
+		Id:         "Type",
+		NTType:     45,
+		Index:      131,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewStructType(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Type : id Indexes	<< ast.NewStructTypeArray(X[0], X[1]) >>`,
+		Id:         "Type",
+		NTType:     45,
+		Index:      132,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewStructTypeArray(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Type : list relop BasicType relop	<< ast.NewListType(X[1], X[2], X[3]) >>`,
+		Id:         "Type",
+		NTType:     45,
+		Index:      133,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewListType(X[1], X[2], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Type : list relop id relop	<< ast.NewListType(X[1], X[2], X[3]) >>`,
+		Id:         "Type",
+		NTType:     45,
+		Index:      134,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return ast.NewTypeArray(X[0], X[2])
+			return ast.NewListType(X[1], X[2], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Dimensions : leftsqrbracket cteint rightsqrbracket Dimensions	<< ast.AppendDimensionList(X[1], X[3]) >>`,
+		Id:         "Dimensions",
+		NTType:     46,
+		Index:      135,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.AppendDimensionList(X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Dimensions : leftsqrbracket cteint rightsqrbracket	<< ast.NewDimensionList(X[1]) >>`,
+		Id:         "Dimensions",
+		NTType:     46,
+		Index:      136,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewDimensionList(X[1])
 		},
 	},
 	ProdTabEntry{
 		String: `Attribute : id dot id	<< ast.NewAttribute(X[0], X[2]) >>`,
 		Id:         "Attribute",
-		NTType:     31,
-		Index:      77,
+		NTType:     47,
+		Index:      137,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return ast.NewAttribute(X[0], X[2])
 		},
 	},
+	ProdTabEntry{
+		String: `Attribute : ListElem dot id	<< ast.NewElemAttribute(X[0], X[2]) >>`,
+		Id:         "Attribute",
+		NTType:     47,
+		Index:      138,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return ast.NewElemAttribute(X[0], X[2])
+		},
+	},
 }
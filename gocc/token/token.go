@@ -126,10 +126,17 @@ var TokMap = TokenMap{
 		"leftbracket",
 		"rightbracket",
 		"empty",
-		"comma",
+		"struct",
+		"class",
+		"colon",
 		"leftparenthesis",
 		"rightparenthesis",
 		"voidtype",
+		"equals",
+		"const",
+		"comma",
+		"break",
+		"continue",
 		"inttype",
 		"floattype",
 		"booltype",
@@ -140,19 +147,28 @@ var TokMap = TokenMap{
 		"imagetype",
 		"texttype",
 		"backgroundtype",
+		"orop",
+		"andop",
+		"eqop",
 		"relop",
-		"logicalop",
 		"plus",
 		"minus",
 		"mult",
 		"div",
-		"equals",
+		"mod",
+		"not",
+		"assignop",
+		"incop",
 		"print",
 		"if",
 		"else",
+		"switch",
+		"case",
+		"default",
 		"return",
 		"for",
 		"while",
+		"dot",
 		"cteint",
 		"ctefloat",
 		"ctestring",
@@ -160,8 +176,6 @@ var TokMap = TokenMap{
 		"ctebool",
 		"leftsqrbracket",
 		"rightsqrbracket",
-		"dot",
-		"colon",
 		"list",
 	},
 
@@ -174,42 +188,56 @@ var TokMap = TokenMap{
 		"leftbracket":      5,
 		"rightbracket":     6,
 		"empty":            7,
-		"comma":            8,
-		"leftparenthesis":  9,
-		"rightparenthesis": 10,
-		"voidtype":         11,
-		"inttype":          12,
-		"floattype":        13,
-		"booltype":         14,
-		"stringtype":       15,
-		"chartype":         16,
-		"squaretype":       17,
-		"circletype":       18,
-		"imagetype":        19,
-		"texttype":         20,
-		"backgroundtype":   21,
-		"relop":            22,
-		"logicalop":        23,
-		"plus":             24,
-		"minus":            25,
-		"mult":             26,
-		"div":              27,
-		"equals":           28,
-		"print":            29,
-		"if":               30,
-		"else":             31,
-		"return":           32,
-		"for":              33,
-		"while":            34,
-		"cteint":           35,
-		"ctefloat":         36,
-		"ctestring":        37,
-		"ctechar":          38,
-		"ctebool":          39,
-		"leftsqrbracket":   40,
-		"rightsqrbracket":  41,
-		"dot":              42,
-		"colon":            43,
-		"list":             44,
+		"struct":           8,
+		"class":            9,
+		"colon":            10,
+		"leftparenthesis":  11,
+		"rightparenthesis": 12,
+		"voidtype":         13,
+		"equals":           14,
+		"const":            15,
+		"comma":            16,
+		"break":            17,
+		"continue":         18,
+		"inttype":          19,
+		"floattype":        20,
+		"booltype":         21,
+		"stringtype":       22,
+		"chartype":         23,
+		"squaretype":       24,
+		"circletype":       25,
+		"imagetype":        26,
+		"texttype":         27,
+		"backgroundtype":   28,
+		"orop":             29,
+		"andop":            30,
+		"eqop":             31,
+		"relop":            32,
+		"plus":             33,
+		"minus":            34,
+		"mult":             35,
+		"div":              36,
+		"mod":              37,
+		"not":              38,
+		"assignop":         39,
+		"incop":            40,
+		"print":            41,
+		"if":               42,
+		"else":             43,
+		"switch":           44,
+		"case":             45,
+		"default":          46,
+		"return":           47,
+		"for":              48,
+		"while":            49,
+		"dot":              50,
+		"cteint":           51,
+		"ctefloat":         52,
+		"ctestring":        53,
+		"ctechar":          54,
+		"ctebool":          55,
+		"leftsqrbracket":   56,
+		"rightsqrbracket":  57,
+		"list":             58,
 	},
 }
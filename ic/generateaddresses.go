@@ -5,6 +5,7 @@ import (
 
 	"github.com/sdkvictor/golang-compiler/ast"
 	"github.com/sdkvictor/golang-compiler/directories"
+	"github.com/sdkvictor/golang-compiler/mem"
 	"github.com/mewkiz/pkg/errutil"
 )
 
@@ -21,6 +22,12 @@ func generateAddressesProgram(program *ast.Program, ctx *GenerationContext) erro
 		return err
 	}
 
+	for _, decl := range program.Decls() {
+		if err := generateAddressesStatement(decl, ctx); err != nil {
+			return err
+		}
+	}
+
 	for _, f := range program.Functions() {
 		if err := generateAddressesFunction(f, ctx); err != nil {
 			return err
@@ -50,6 +57,13 @@ func generateAddressesFuncEntry(fe *directories.FuncEntry, ctx *GenerationContex
 	// Now we can request address assigning using the sorted array which
 	// will guarantee that the addresses are given in order
 	for _, ve := range ves {
+		if ve.IsConstant() {
+			if err := generateAddressesConstantEntry(ve, ctx); err != nil {
+				return err
+			}
+			continue
+		}
+
 		addr, err := ctx.vm.GetNextLocal(ve.Type())
 		if err != nil {
 			return err
@@ -62,6 +76,13 @@ func generateAddressesFuncEntry(fe *directories.FuncEntry, ctx *GenerationContex
 
 func generateAddressesGlobals(globals *directories.VarDirectory, ctx *GenerationContext) error {
 	for _, ve := range globals.Table() {
+		if ve.IsConstant() {
+			if err := generateAddressesConstantEntry(ve, ctx); err != nil {
+				return err
+			}
+			continue
+		}
+
 		addr, err := ctx.vm.GetNextGlobal(ve.Type())
 		if err != nil {
 			return err
@@ -72,6 +93,17 @@ func generateAddressesGlobals(globals *directories.VarDirectory, ctx *Generation
 	return nil
 }
 
+// generateAddressesConstantEntry gives a constant the address of its folded value in the constant segment
+func generateAddressesConstantEntry(ve *directories.VarEntry, ctx *GenerationContext) error {
+	addr, err := ctx.vm.AddConstant(mem.ConstantKey(ve.Value(), ve.Type()), ve.Type())
+	if err != nil {
+		return err
+	}
+	ve.SetAddress(addr)
+
+	return nil
+}
+
 func generateAddressesFunction(function *ast.Function, ctx *GenerationContext) error {
 	for _, s := range function.Statements() {
 		if err := generateAddressesStatement(s, ctx); err != nil {
@@ -83,12 +115,17 @@ func generateAddressesFunction(function *ast.Function, ctx *GenerationContext) e
 }
 
 func generateAddressesStatement(statement ast.Statement, ctx *GenerationContext) error {
-	if _, ok := statement.(*ast.Vars); ok {
-		return nil
+	if vars, ok := statement.(*ast.Vars); ok {
+		if vars.Init() == nil {
+			return nil
+		}
+		return generateAddressesAssign(vars.Init(), ctx)
 	} else if assign, ok := statement.(*ast.Assign); ok {
 		return generateAddressesAssign(assign, ctx)
 	} else if condition, ok := statement.(*ast.Condition); ok {
 		return generateAddressesCondition(condition, ctx)
+	} else if sw, ok := statement.(*ast.Switch); ok {
+		return generateAddressesSwitch(sw, ctx)
 	} else if write, ok := statement.(*ast.Write); ok {
 		return generateAddressesWrite(write, ctx)
 	} else if ret, ok := statement.(*ast.Return); ok {
@@ -99,6 +136,12 @@ func generateAddressesStatement(statement ast.Statement, ctx *GenerationContext)
 		return generateAddressesWhile(while, ctx)
 	} else if fcall, ok := statement.(*ast.FunctionCall); ok {
 		return generateAddressesFunctionCall(fcall, ctx)
+	} else if mcall, ok := statement.(*ast.ClassFunctionCall); ok {
+		return generateAddressesMethodCall(mcall, ctx)
+	} else if _, ok := statement.(*ast.Break); ok {
+		return nil
+	} else if _, ok := statement.(*ast.Continue); ok {
+		return nil
 	}
 
 	return errutil.NewNoPosf("Cannot cast statement to valid form")
@@ -136,6 +179,28 @@ func generateAddressesCondition(condition *ast.Condition, ctx *GenerationContext
 	return nil
 }
 
+func generateAddressesSwitch(sw *ast.Switch, ctx *GenerationContext) error {
+	if err := generateAddressesExpression(sw.Expression(), ctx); err != nil {
+		return err
+	}
+
+	for _, c := range sw.Cases() {
+		for _, v := range c.Values() {
+			if err := generateAddressesFactor(v, ctx); err != nil {
+				return err
+			}
+		}
+
+		for _, s := range c.Statements() {
+			if err := generateAddressesStatement(s, ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func generateAddressesWrite(write *ast.Write, ctx *GenerationContext) error {
 	if err := generateAddressesExpression(write.Expression(), ctx); err != nil {
 		return err
@@ -191,8 +256,8 @@ func generateAddressesWhile(w *ast.While, ctx *GenerationContext) error {
 
 
 func generateAddressesAttribute(att *ast.Attribute, ctx *GenerationContext) error {
-	if att.Index() != nil {
-		if err := generateAddressesExpression(att.Index(), ctx); err != nil {
+	for _, index := range att.Indexes() {
+		if err := generateAddressesExpression(index, ctx); err != nil {
 			return err
 		}
 	}
@@ -201,27 +266,7 @@ func generateAddressesAttribute(att *ast.Attribute, ctx *GenerationContext) erro
 }
 
 func generateAddressesExpression(expression *ast.Expression, ctx *GenerationContext) error {
-	for _, e := range expression.Exps() {
-		if err := generateAddressesExp(e, ctx); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
-func generateAddressesExp(exp *ast.Exp, ctx *GenerationContext) error {
-	for _, t := range exp.Terms() {
-		if err := generateAddressesTerm(t, ctx); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
-func generateAddressesTerm(term *ast.Term, ctx *GenerationContext) error {
-	for _, f := range term.Factors() {
+	for _, f := range expression.Factors() {
 		if err := generateAddressesFactor(f, ctx); err != nil {
 			return err
 		}
@@ -253,16 +298,28 @@ func generateAddressesConstant(c ast.Constant, ctx *GenerationContext) error {
 		return generateAddressesAttribute(att, ctx)
 	} else if fc, ok := c.(*ast.FunctionCall); ok {
 		return generateAddressesFunctionCall(fc, ctx)
+	} else if mc, ok := c.(*ast.ClassFunctionCall); ok {
+		return generateAddressesMethodCall(mc, ctx)
 	} else if le, ok := c.(*ast.ListElem); ok {
 		return generateAddressesListElem(le, ctx)
+	} else if cast, ok := c.(*ast.Cast); ok {
+		return generateAddressesExpression(cast.Expression(), ctx)
+	} else if ol, ok := c.(*ast.ObjectLiteral); ok {
+		for _, fi := range ol.Fields() {
+			if err := generateAddressesExpression(fi.Expression(), ctx); err != nil {
+				return err
+			}
+		}
+		return nil
 	}
 
 	return errutil.Newf("Cannot cast constant to any valid form %+v", c.Token())
 }
 
 func generateAddressesConstantValue(cv *ast.ConstantValue, ctx *GenerationContext) error {
-	if !ctx.vm.ConstantExists(cv.Value()) {
-		ctx.vm.AddConstant(cv.Value(), cv.Type())
+	key := mem.ConstantKey(cv.Value(), cv.Type())
+	if !ctx.vm.ConstantExists(key) {
+		ctx.vm.AddConstant(key, cv.Type())
 	}
 
 	return nil
@@ -278,9 +335,21 @@ func generateAddressesFunctionCall(fcall *ast.FunctionCall, ctx *GenerationConte
 	return nil
 }
 
+func generateAddressesMethodCall(mcall *ast.ClassFunctionCall, ctx *GenerationContext) error {
+	for _, args := range mcall.Params() {
+		if err := generateAddressesExpression(args, ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func generateAddressesListElem(le *ast.ListElem, ctx *GenerationContext) error {
-	if err := generateAddressesExpression(le.Index(), ctx); err != nil {
-		return err
+	for _, index := range le.Indexes() {
+		if err := generateAddressesExpression(index, ctx); err != nil {
+			return err
+		}
 	}
 
 	return nil
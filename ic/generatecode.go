@@ -2,9 +2,9 @@ package ic
 
 import (
 	"strconv"
-	"fmt"
 	"github.com/sdkvictor/golang-compiler/ast"
 	"github.com/sdkvictor/golang-compiler/directories"
+	"github.com/sdkvictor/golang-compiler/gocc/token"
 	"github.com/sdkvictor/golang-compiler/mem"
 	"github.com/sdkvictor/golang-compiler/quad"
 	"github.com/sdkvictor/golang-compiler/semantics"
@@ -19,6 +19,21 @@ func generateCodeProgram(program *ast.Program, ctx *GenerationContext) error {
 		return err
 	}
 
+	// The initial values of the globals are assigned in the order of the source before main is called
+	scope := semantics.GlobalScope()
+	prev := ctx.gen.Position()
+	for _, decl := range program.Decls() {
+		if decl.Init() == nil || decl.Variables()[0].IsConstant() {
+			continue
+		}
+
+		ctx.gen.SetPosition(decl.Token().Pos)
+		if err := generateCodeAssign(decl.Init(), ctx, scope); err != nil {
+			return err
+		}
+	}
+	ctx.gen.SetPosition(prev)
+
 	// This statement adds the initial goto to the pending jumps
 	ctx.gen.AddPendingFuncAddr(ctx.gen.ICounter(), "main")
 
@@ -38,14 +53,19 @@ func generateCodeProgram(program *ast.Program, ctx *GenerationContext) error {
 
 func generateCodeGlobals(globals *directories.VarDirectory, ctx *GenerationContext) error {
 	for _, ve := range globals.Table() {
-		if ve.Type().List() > 0 {
-			nt := ve.Type().Copy()
-			nt.DecreaseList()
+		if ve.IsConstant() {
+			continue
+		} else if ve.Type().IsDynamic() {
+			ctx.gen.Generate(quad.Init, ve.Address(), mem.Address(1), mem.Address(types.ListInitCode))
+		} else if ve.Type().IsStruct() {
+			generateCodeStructInit(ve, ctx)
+		} else if ve.Type().List() > 0 {
+			nt := ve.Type().Elem()
 			typeint, err := strconv.ParseInt(nt.String(), 16, 64)
 			if err != nil {
 				return err
 			}
-			ctx.gen.Generate(quad.Init, ve.Address(), mem.Address(ve.Type().Size()), mem.Address(typeint))
+			ctx.gen.Generate(quad.Init, ve.Address(), mem.Address(ve.Type().Elements()), mem.Address(typeint))
 		} else {
 			nt := ve.Type().Copy()
 			nt.DecreaseList()
@@ -59,6 +79,78 @@ func generateCodeGlobals(globals *directories.VarDirectory, ctx *GenerationConte
 	return nil
 }
 
+// generateCodeStructInit initializes the header of every struct of the variable followed by each one of its fields
+func generateCodeStructInit(ve *directories.VarEntry, ctx *GenerationContext) {
+	st := ve.Type().Struct()
+	amount := ve.Type().Elements()
+
+	for i := 0; i < amount; i++ {
+		base := int(ve.Address()) + i*st.Size()
+		ctx.gen.Generate(quad.Init, mem.Address(base), mem.Address(len(st.Fields())), mem.Address(types.StructInitCode))
+		for _, f := range st.Fields() {
+			typeint, _ := strconv.ParseInt(f.Type().String(), 16, 64)
+			ctx.gen.Generate(quad.Init, mem.Address(base+f.Offset()), mem.Address(1), mem.Address(typeint))
+		}
+	}
+}
+
+// generateCodeIndex generates the indexes of an array and returns the offset of the element from the
+// start of the array. The elements are stored in row-major order, every index is checked against the
+// size of its own dimension, and the offset is multiplied by the size of a struct for arrays of structs
+func generateCodeIndex(indexes []*ast.Expression, t *types.Type, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
+	inttype := types.NewDataType(types.Int, 0, 0)
+	offset := mem.Address(-1)
+
+	for i, index := range indexes {
+		addrE, err := generateCodeExpression(index, ctx, fe)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+		ctx.gen.Generate(quad.CheckBound, mem.Address(t.Dims()[i]), mem.Address(-1), addrE)
+
+		if i == 0 {
+			offset = addrE
+			continue
+		}
+
+		// offset = offset * size of the dimension + index
+		sizeAddr, err := ctx.vm.AddConstant(strconv.Itoa(t.Dims()[i]), inttype)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+
+		rowTmp, err := ctx.vm.GetNextTemp(inttype)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+		ctx.gen.Generate(quad.Mult, offset, sizeAddr, rowTmp)
+
+		offsetTmp, err := ctx.vm.GetNextTemp(inttype)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+		ctx.gen.Generate(quad.Add, rowTmp, addrE, offsetTmp)
+		offset = offsetTmp
+	}
+
+	if !t.IsStruct() {
+		return offset, nil
+	}
+
+	sizeAddr, err := ctx.vm.AddConstant(strconv.Itoa(t.Struct().Size()), inttype)
+	if err != nil {
+		return mem.Address(-1), err
+	}
+
+	offsetTmp, err := ctx.vm.GetNextTemp(inttype)
+	if err != nil {
+		return mem.Address(-1), err
+	}
+
+	ctx.gen.Generate(quad.Mult, offset, sizeAddr, offsetTmp)
+	return offsetTmp, nil
+}
+
 // generateCodeFunction takes a function node and generate its corresponding quadruples.
 // Function nodes always end with a RET quad
 func generateCodeFunction(function *ast.Function, ctx *GenerationContext) error {
@@ -80,12 +172,20 @@ func generateCodeFunction(function *ast.Function, ctx *GenerationContext) error
 
 // generateCodeStatement checks the type of the statement and calls the specific function to generate the code
 func generateCodeStatement(statement ast.Statement, ctx *GenerationContext, fe *directories.FuncEntry) error {
+	// The quads of the statement get its position, the ones of nested statements get their own
+	// and the position of the enclosing statement is restored once it is done
+	prev := ctx.gen.Position()
+	ctx.gen.SetPosition(statement.Token().Pos)
+	defer ctx.gen.SetPosition(prev)
+
 	if vars, ok := statement.(*ast.Vars); ok {
 		return generateCodeVars(vars, ctx, fe)
 	} else if assign, ok := statement.(*ast.Assign); ok {
 		return generateCodeAssign(assign, ctx, fe)
 	} else if condition, ok := statement.(*ast.Condition); ok {
 		return generateCodeCondition(condition, ctx, fe)
+	} else if sw, ok := statement.(*ast.Switch); ok {
+		return generateCodeSwitch(sw, ctx, fe)
 	} else if write, ok := statement.(*ast.Write); ok {
 		return generateCodeWrite(write, ctx, fe)
 	} else if ret, ok := statement.(*ast.Return); ok {
@@ -100,6 +200,13 @@ func generateCodeStatement(statement ast.Statement, ctx *GenerationContext, fe *
 			return err
 		}
 		return nil
+	} else if mcall, ok := statement.(*ast.ClassFunctionCall); ok {
+		_, err := generateCodeMethodCall(mcall, ctx, fe)
+		return err
+	} else if _, ok := statement.(*ast.Break); ok {
+		return generateCodeBreak(ctx)
+	} else if _, ok := statement.(*ast.Continue); ok {
+		return generateCodeContinue(ctx)
 	}
 
 	return errutil.Newf("Cannot cast statement to valid form: %T", statement)
@@ -107,15 +214,20 @@ func generateCodeStatement(statement ast.Statement, ctx *GenerationContext, fe *
 
 func generateCodeVars(vars *ast.Vars, ctx *GenerationContext, fe *directories.FuncEntry) error {
 	for _, ve := range vars.Variables() {
-		if ve.Type().List() > 0 {
-			nt := ve.Type().Copy()
-			nt.DecreaseList()
+		if ve.IsConstant() {
+			// A constant is already stored in the constant segment
+			return nil
+		} else if ve.Type().IsDynamic() {
+			ctx.gen.Generate(quad.Init, ve.Address(), mem.Address(1), mem.Address(types.ListInitCode))
+		} else if ve.Type().IsStruct() {
+			generateCodeStructInit(ve, ctx)
+		} else if ve.Type().List() > 0 {
+			nt := ve.Type().Elem()
 			typeint, err := strconv.ParseInt(nt.String(), 16, 64)
 			if err != nil {
 				return err
 			}
-			ctx.gen.Generate(quad.Init, ve.Address(), mem.Address(ve.Type().Size()), mem.Address(typeint))
-			fmt.Printf("GENERATING LIST SIZE: %d\n", ve.Type().Size())
+			ctx.gen.Generate(quad.Init, ve.Address(), mem.Address(ve.Type().Elements()), mem.Address(typeint))
 		} else {
 			nt := ve.Type().Copy()
 			nt.DecreaseList()
@@ -126,15 +238,29 @@ func generateCodeVars(vars *ast.Vars, ctx *GenerationContext, fe *directories.Fu
 			ctx.gen.Generate(quad.Init, ve.Address(), mem.Address(1), mem.Address(typeint))
 		}
 	}
+
+	if vars.Init() != nil {
+		return generateCodeAssign(vars.Init(), ctx, fe)
+	}
 	return nil
 }
 
 
 func generateCodeAssign(assign *ast.Assign, ctx *GenerationContext, fe *directories.FuncEntry) error {
+	if assign.Operation() != "" {
+		return generateCodeCompoundAssign(assign, ctx, fe)
+	}
+
 	addrE, err := generateCodeExpression(assign.Expression(), ctx, fe)
 	if err != nil {
 		return err
 	}
+	expType := ctx.gen.GetFromTypeStack()
+
+	addrE, err = generateCodePromotion(addrE, expType, assignedType(assign.Attribute(), ctx, fe), ctx)
+	if err != nil {
+		return err
+	}
 
 	if err := generateCodeAttributeAssign(assign.Attribute(), ctx, fe, addrE); err != nil {
 		return err
@@ -143,47 +269,263 @@ func generateCodeAssign(assign *ast.Assign, ctx *GenerationContext, fe *director
 	return nil
 }
 
+// generateCodeCompoundAssign reads the target, operates it with the expression and stores the result in the
+// same target, so the indexes of an element are evaluated once
+func generateCodeCompoundAssign(assign *ast.Assign, ctx *GenerationContext, fe *directories.FuncEntry) error {
+	t := assignedType(assign.Attribute(), ctx, fe)
+
+	target, err := generateCodeAssignTarget(assign.Attribute(), ctx, fe)
+	if err != nil {
+		return err
+	}
+
+	addrT, err := generateCodeLoad(target, t, ctx)
+	if err != nil {
+		return err
+	}
+	ctx.gen.PushToTypeStack(t)
+
+	addrE, err := generateCodeExpression(assign.Expression(), ctx, fe)
+	if err != nil {
+		return err
+	}
+
+	res, err := generateCodeOperation(assign.Operation(), addrT, addrE, assign.Expression().Token(), ctx)
+	if err != nil {
+		return err
+	}
+	resType := ctx.gen.GetFromTypeStack()
+
+	res, err = generateCodePromotion(res, resType, t, ctx)
+	if err != nil {
+		return err
+	}
+
+	generateCodeStore(target, res, ctx)
+	return nil
+}
+
+// assignedType is the type of the variable, element, field or attribute changed by an assignment
+func assignedType(att *ast.Attribute, ctx *GenerationContext, fe *directories.FuncEntry) *types.Type {
+	ve := fe.VarDir().Get(att.ObjId())
+	if ve == nil {
+		ve = ctx.Globals().Get(att.ObjId())
+	}
+
+	t := ve.Type()
+	if len(att.Indexes()) > 0 {
+		t = t.Elem()
+	}
+
+	if att.VarId() == "" {
+		return t
+	}
+
+	if t.IsStruct() {
+		if field := t.Struct().Field(att.VarId()); field != nil {
+			return field.Type()
+		}
+	}
+
+	return semantics.GetObjectAttributeType(att.VarId())
+}
+
+// generateCodePromotion converts the int at addr to a float when it is stored in a float,
+// any other value is stored as it is
+func generateCodePromotion(addr mem.Address, from, to *types.Type, ctx *GenerationContext) (mem.Address, error) {
+	if !semantics.IsPromotion(from, to) {
+		return addr, nil
+	}
+
+	tmp, err := ctx.vm.GetNextTemp(to)
+	if err != nil {
+		return mem.Address(-1), err
+	}
+
+	ctx.gen.Generate(quad.IntToFloat, addr, mem.Address(-1), tmp)
+	return tmp, nil
+}
+
+// targetKind tells how the value of an assignTarget is read and stored
+type targetKind int
+
+const (
+	targetAddress  targetKind = iota // The value is at addr
+	targetPointer                    // addr holds the address of an element of an array or of one of its fields
+	targetListElem                   // addr holds the index of an element of the list
+)
+
+// assignTarget is where an assignment stores its value. It is located once, so the indexes of an element
+// are evaluated a single time even when a compound assignment reads it first. When addr is inside copy,
+// a copy of the value of parent, the copy is stored back in parent after changing it
+type assignTarget struct {
+	kind   targetKind
+	addr   mem.Address
+	ve     *directories.VarEntry
+	copy   mem.Address
+	parent *assignTarget
+}
+
 func generateCodeAttributeAssign(att *ast.Attribute, ctx *GenerationContext, fe *directories.FuncEntry, expTmp mem.Address) error {
+	target, err := generateCodeAssignTarget(att, ctx, fe)
+	if err != nil {
+		return err
+	}
+
+	generateCodeStore(target, expTmp, ctx)
+	return nil
+}
+
+// generateCodeAssignTarget locates the variable, element, field or attribute changed by an assignment
+func generateCodeAssignTarget(att *ast.Attribute, ctx *GenerationContext, fe *directories.FuncEntry) (*assignTarget, error) {
 	ve := fe.VarDir().Get(att.ObjId())
 	if ve == nil {
 		ve = ctx.Globals().Get(att.ObjId())
 		if ve == nil {
-			return errutil.Newf("Id %s could not be found in local or global scope. (check scopecheck?)", att.ObjId())
+			return nil, errutil.Newf("Id %s could not be found in local or global scope. (check scopecheck?)", att.ObjId())
 		}
 	}
 
-	// Caso que variable es indexada
-	if att.Index() != nil {
-		addrE, err := generateCodeExpression(att.Index(), ctx, fe)
+	target := &assignTarget{targetAddress, ve.Address(), ve, mem.Address(-1), nil}
+	t := ve.Type()
+
+	// Caso que variable es lista, el elemento se reemplaza en el heap
+	if len(att.Indexes()) > 0 && ve.Type().IsDynamic() {
+		addrE, err := generateCodeExpression(att.Indexes()[0], ctx, fe)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		_ = ctx.gen.GetFromTypeStack()
+
+		target = &assignTarget{targetListElem, addrE, ve, mem.Address(-1), nil}
+		t = t.Elem()
+	} else if len(att.Indexes()) > 0 {
+		// Caso que variable es indexada
+		addrE, err := generateCodeIndex(att.Indexes(), ve.Type(), ctx, fe)
+		if err != nil {
+			return nil, err
 		}
-		ctx.gen.Generate(quad.CheckBound, mem.Address(ve.Type().Size()), mem.Address(-1), addrE)
 
 		addrTmp, err := ctx.vm.GetNextTemp(types.NewDataType(types.Int, 0, 0))
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		ctx.gen.Generate(quad.AddAddr, ve.Address(), addrE, addrTmp)
-		ctx.gen.Generate(quad.AssignIndex, expTmp, mem.Address(-1), addrTmp)
-		// TODO: Checar estos quads en la maquina virtual
+		target = &assignTarget{targetPointer, addrTmp, ve, mem.Address(-1), nil}
+		t = t.Elem()
+	}
 
-		return nil
+	// Caso de variable simple o elemento
+	if att.VarId() == "" {
+		return target, nil
 	}
 
-	// Caso de variable no indexada
+	// Caso de campo de un struct
+	if t.IsStruct() {
+		st := t.Struct()
+		if field := st.Field(att.VarId()); field != nil {
+			return generateCodeOffsetTarget(target, t, field.Offset(), ctx)
+		}
+
+		// The attribute of the embedded object is changed in a copy of it, which is then stored back
+		objTarget, err := generateCodeOffsetTarget(target, t, st.Embedded().Offset(), ctx)
+		if err != nil {
+			return nil, err
+		}
+		objTarget, err = generateCodeCopyTarget(objTarget, st.Embedded().Type(), ctx)
+		if err != nil {
+			return nil, err
+		}
+		return generateCodeOffsetTarget(objTarget, st.Embedded().Type(), types.GetAttributeOffset(att.VarId()), ctx)
+	}
 
 	// Caso de variable con objeto
-	if att.VarId() != "" {
-		actualAddr := mem.Address(int(ve.Address()) + types.GetAttributeOffset(att.VarId()))
-		ctx.gen.Generate(quad.Assign, expTmp, mem.Address(-1), actualAddr)
-		return nil
+	return generateCodeOffsetTarget(target, t, types.GetAttributeOffset(att.VarId()), ctx)
+}
+
+// generateCodeOffsetTarget locates the cell at offset from the start of the value of type t in the target.
+// An element of a list is copied out of the heap first
+func generateCodeOffsetTarget(target *assignTarget, t *types.Type, offset int, ctx *GenerationContext) (*assignTarget, error) {
+	switch target.kind {
+	case targetListElem:
+		copied, err := generateCodeCopyTarget(target, t, ctx)
+		if err != nil {
+			return nil, err
+		}
+		return generateCodeOffsetTarget(copied, t, offset, ctx)
+	case targetPointer:
+		inttype := types.NewDataType(types.Int, 0, 0)
+		offsetAddr, err := ctx.vm.AddConstant(strconv.Itoa(offset), inttype)
+		if err != nil {
+			return nil, err
+		}
+
+		addrTmp, err := ctx.vm.GetNextTemp(inttype)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.gen.Generate(quad.Add, target.addr, offsetAddr, addrTmp)
+		return &assignTarget{targetPointer, addrTmp, target.ve, mem.Address(-1), nil}, nil
+	default:
+		return &assignTarget{targetAddress, mem.Address(int(target.addr) + offset), target.ve, target.copy, target.parent}, nil
+	}
+}
+
+// generateCodeCopyTarget copies the value of type t in the target to a temp, which is changed instead of it
+func generateCodeCopyTarget(target *assignTarget, t *types.Type, ctx *GenerationContext) (*assignTarget, error) {
+	tmp, err := ctx.vm.GetNextTemp(t)
+	if err != nil {
+		return nil, err
+	}
+
+	switch target.kind {
+	case targetListElem:
+		ctx.gen.Generate(quad.ListGet, target.ve.Address(), target.addr, tmp)
+	case targetPointer:
+		ctx.gen.Generate(quad.AssignIndexInv, target.addr, mem.Address(-1), tmp)
+	default:
+		ctx.gen.Generate(quad.Assign, target.addr, mem.Address(-1), tmp)
+	}
+
+	return &assignTarget{targetAddress, tmp, target.ve, tmp, target}, nil
+}
+
+// generateCodeLoad copies the value of an element to a temp of type t, any other target is read at its address
+func generateCodeLoad(target *assignTarget, t *types.Type, ctx *GenerationContext) (mem.Address, error) {
+	if target.kind != targetListElem && target.kind != targetPointer {
+		return target.addr, nil
+	}
+
+	tmp, err := ctx.vm.GetNextTemp(t)
+	if err != nil {
+		return mem.Address(-1), err
+	}
+
+	if target.kind == targetListElem {
+		ctx.gen.Generate(quad.ListGet, target.ve.Address(), target.addr, tmp)
+	} else {
+		ctx.gen.Generate(quad.AssignIndexInv, target.addr, mem.Address(-1), tmp)
+	}
+
+	return tmp, nil
+}
+
+// generateCodeStore stores the value in the target, and the copy that contains it in its parent
+func generateCodeStore(target *assignTarget, value mem.Address, ctx *GenerationContext) {
+	switch target.kind {
+	case targetListElem:
+		ctx.gen.Generate(quad.ListSet, value, target.addr, target.ve.Address())
+	case targetPointer:
+		ctx.gen.Generate(quad.AssignIndex, value, mem.Address(-1), target.addr)
+	default:
+		ctx.gen.Generate(quad.Assign, value, mem.Address(-1), target.addr)
+	}
+
+	if target.parent != nil {
+		generateCodeStore(target.parent, target.copy, ctx)
 	}
-	
-	// Caso de variable simple
-	ctx.gen.Generate(quad.Assign, expTmp, mem.Address(-1), ve.Address())
-	return nil
 }
 
 func generateCodeAttributeConstant(att *ast.Attribute, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
@@ -195,13 +537,29 @@ func generateCodeAttributeConstant(att *ast.Attribute, ctx *GenerationContext, f
 		}
 	}
 
+	// The field of an element is read from the target an assignment to it would change
+	if len(att.Indexes()) > 0 && att.VarId() != "" {
+		target, err := generateCodeAssignTarget(att, ctx, fe)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+
+		t := assignedType(att, ctx, fe)
+		addr, err := generateCodeLoad(target, t, ctx)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+
+		ctx.gen.PushToTypeStack(t)
+		return addr, nil
+	}
+
 	// Caso que variable es indexada
-	if att.Index() != nil {
-		addrE, err := generateCodeExpression(att.Index(), ctx, fe)
+	if len(att.Indexes()) > 0 {
+		addrE, err := generateCodeIndex(att.Indexes(), ve.Type(), ctx, fe)
 		if err != nil {
 			return mem.Address(-1), err
 		}
-		ctx.gen.Generate(quad.CheckBound, mem.Address(ve.Type().Size()), mem.Address(-1), addrE)
 
 		addrTmp, err := ctx.vm.GetNextTemp(types.NewDataType(types.Int, 0, 0))
 		if err != nil {
@@ -210,7 +568,9 @@ func generateCodeAttributeConstant(att *ast.Attribute, ctx *GenerationContext, f
 
 		ctx.gen.Generate(quad.AddAddr, ve.Address(), addrE, addrTmp)
 
-		finalTmp, err := ctx.vm.GetNextTemp(ve.Type())
+		nt := ve.Type().Elem()
+
+		finalTmp, err := ctx.vm.GetNextTemp(nt)
 		if err != nil {
 			return mem.Address(-1), err
 		}
@@ -218,14 +578,30 @@ func generateCodeAttributeConstant(att *ast.Attribute, ctx *GenerationContext, f
 		ctx.gen.Generate(quad.AssignIndexInv, addrTmp, mem.Address(-1), finalTmp)
 		// TODO: Checar estos quads en la maquina virtual
 
-		nt := ve.Type().Copy()
-		nt.DecreaseList()
 		ctx.gen.PushToTypeStack(nt)
 		return finalTmp, nil
 	}
 
 	// Caso de variable no indexada
 
+	// Caso de campo de un struct
+	if att.VarId() != "" && ve.Type().IsStruct() {
+		st := ve.Type().Struct()
+		if field := st.Field(att.VarId()); field != nil {
+			ctx.gen.PushToTypeStack(field.Type())
+			return mem.Address(int(ve.Address()) + field.Offset()), nil
+		}
+
+		// The attribute of the embedded object is read from a copy of it
+		objTmp, err := ctx.vm.GetNextTemp(st.Embedded().Type())
+		if err != nil {
+			return mem.Address(-1), err
+		}
+		ctx.gen.Generate(quad.Assign, mem.Address(int(ve.Address()) + st.Embedded().Offset()), mem.Address(-1), objTmp)
+		ctx.gen.PushToTypeStack(semantics.GetObjectAttributeType(att.VarId()))
+		return mem.Address(int(objTmp) + types.GetAttributeOffset(att.VarId())), nil
+	}
+
 	// Caso de variable con objeto
 	if att.VarId() != "" {
 		// TODO: resolver cuando se resuelva addresses con objetos
@@ -248,11 +624,48 @@ func generateCodeListElem(le *ast.ListElem, ctx *GenerationContext, fe *director
 		}
 	}
 
-	addrE, err := generateCodeExpression(le.Index(), ctx, fe)
+	// The character of a string is copied to a temp of type char
+	if ve.Type().Equal(types.NewDataType(types.String, 0, 0)) {
+		addrE, err := generateCodeExpression(le.Indexes()[0], ctx, fe)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+		_ = ctx.gen.GetFromTypeStack()
+
+		nt := types.NewDataType(types.Char, 0, 0)
+		tmp, err := ctx.vm.GetNextTemp(nt)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+
+		ctx.gen.Generate(quad.StrIndex, ve.Address(), addrE, tmp)
+		ctx.gen.PushToTypeStack(nt)
+		return tmp, nil
+	}
+
+	// The element of a list is copied from the heap
+	if ve.Type().IsDynamic() {
+		addrE, err := generateCodeExpression(le.Indexes()[0], ctx, fe)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+		_ = ctx.gen.GetFromTypeStack()
+
+		nt := ve.Type().Elem()
+		tmp, err := ctx.vm.GetNextTemp(nt)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+
+		ctx.gen.Generate(quad.ListGet, ve.Address(), addrE, tmp)
+		ctx.gen.PushToTypeStack(nt)
+		return tmp, nil
+	}
+
+	addrE, err := generateCodeIndex(le.Indexes(), ve.Type(), ctx, fe)
 	if err != nil {
 		return mem.Address(-1), err
 	}
-	ctx.gen.Generate(quad.CheckBound, mem.Address(ve.Type().Size()), mem.Address(-1), addrE)
 
 	addrTmp, err := ctx.vm.GetNextTemp(types.NewDataType(types.Int, 0, 0))
 	if err != nil {
@@ -261,7 +674,9 @@ func generateCodeListElem(le *ast.ListElem, ctx *GenerationContext, fe *director
 
 	ctx.gen.Generate(quad.AddAddr, ve.Address(), addrE, addrTmp)
 
-	finalTmp, err := ctx.vm.GetNextTemp(ve.Type())
+	nt := ve.Type().Elem()
+
+	finalTmp, err := ctx.vm.GetNextTemp(nt)
 	if err != nil {
 		return mem.Address(-1), err
 	}
@@ -269,136 +684,178 @@ func generateCodeListElem(le *ast.ListElem, ctx *GenerationContext, fe *director
 	ctx.gen.Generate(quad.AssignIndexInv, addrTmp, mem.Address(-1), finalTmp)
 	// TODO: Checar estos quads en la maquina virtual
 
-	nt := ve.Type().Copy()
-	nt.DecreaseList()
 	ctx.gen.PushToTypeStack(nt)
 	return finalTmp, nil
 }
 
+// generateCodeOperation generates the quad of a binary operation whose operands were already generated,
+// their types are taken from the type stack and the type of the result is pushed to it
+func generateCodeOperation(op string, prevAddr, nextAddr mem.Address, nextTok *token.Token, ctx *GenerationContext) (mem.Address, error) {
+	nextType := ctx.gen.GetFromTypeStack()
+	prevType := ctx.gen.GetFromTypeStack()
+
+	semCubeKey := semantics.GetSemanticCubeKey(op, []*types.Type{prevType, nextType})
+	basic, ok := ctx.SemCube().Get(semCubeKey)
+	if !ok {
+		return mem.Address(-1), errutil.Newf("%+v: Invalid operation %v %s %v", nextTok, prevType, op, nextType)
+	}
+
+	// An int operated with a float is converted to float first
+	var err error
+	if prevAddr, err = generateCodePromotion(prevAddr, prevType, nextType, ctx); err != nil {
+		return mem.Address(-1), err
+	}
+	if nextAddr, err = generateCodePromotion(nextAddr, nextType, prevType, ctx); err != nil {
+		return mem.Address(-1), err
+	}
+
+	genType := types.NewDataType(basic, 0, 0)
+
+	tmp, err := ctx.vm.GetNextTemp(genType)
+	if err != nil {
+		return mem.Address(-1), err
+	}
+
+	// The operation is located at its right operand
+	prevPos := ctx.gen.Position()
+	ctx.gen.SetPosition(nextTok.Pos)
+	err = generateOperationQuad(op, prevAddr, nextAddr, tmp, ctx)
+	ctx.gen.SetPosition(prevPos)
+	if err != nil {
+		return mem.Address(-1), err
+	}
+
+	ctx.gen.PushToTypeStack(genType)
+	return tmp, nil
+}
+
 func generateCodeExpression(expression *ast.Expression, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
-	prevExp := expression.Exps()[0]
-	prevAddr, err := generateCodeExp(prevExp, ctx, fe)
+	prevAddr, err := generateCodeAndExp(expression.AndExps()[0], ctx, fe)
 	if err != nil {
 		return mem.Address(-1), err
 	}
-	
+
 	for i, op := range expression.Operations() {
-		nextExp := expression.Exps()[i+1]
-		nextAddr, err := generateCodeExp(nextExp, ctx, fe)
+		next := expression.AndExps()[i+1]
+		nextAddr, err := generateCodeAndExp(next, ctx, fe)
 		if err != nil {
 			return mem.Address(-1), err
 		}
 
-		prevType := ctx.gen.GetFromTypeStack()
-		nextType := ctx.gen.GetFromTypeStack()
-
-		semCubeKey := semantics.GetSemanticCubeKey(op, []*types.Type{prevType, nextType})
-		basic, ok := ctx.SemCube().Get(semCubeKey)
-		if !ok {
-			return mem.Address(-1), errutil.Newf("%+v: Invalid operation %v %s %v", nextExp.Token(), prevType, op, nextType)
+		prevAddr, err = generateCodeOperation(op, prevAddr, nextAddr, next.Token(), ctx)
+		if err != nil {
+			return mem.Address(-1), err
 		}
+	}
 
-		genType := types.NewDataType(basic, 0, 0)
+	return prevAddr, nil
+}
 
-		tmp, err := ctx.vm.GetNextTemp(genType)
+func generateCodeAndExp(and *ast.AndExp, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
+	prevAddr, err := generateCodeEqualityExp(and.EqualityExps()[0], ctx, fe)
+	if err != nil {
+		return mem.Address(-1), err
+	}
+
+	for i, op := range and.Operations() {
+		next := and.EqualityExps()[i+1]
+		nextAddr, err := generateCodeEqualityExp(next, ctx, fe)
 		if err != nil {
 			return mem.Address(-1), err
 		}
 
-		if err := generateOperationQuad(op, prevAddr, nextAddr, tmp, ctx); err != nil {
+		prevAddr, err = generateCodeOperation(op, prevAddr, nextAddr, next.Token(), ctx)
+		if err != nil {
 			return mem.Address(-1), err
 		}
-
-		prevAddr = tmp
-		prevExp = nextExp
-
-		ctx.gen.PushToTypeStack(genType)
 	}
 
 	return prevAddr, nil
 }
 
-func generateCodeExp(exp *ast.Exp, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
-	prevTerm := exp.Terms()[0]
-	prevAddr, err := generateCodeTerm(prevTerm, ctx, fe)
+func generateCodeEqualityExp(eq *ast.EqualityExp, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
+	prevAddr, err := generateCodeRelationalExp(eq.RelationalExps()[0], ctx, fe)
 	if err != nil {
 		return mem.Address(-1), err
 	}
-	
-	for i, op := range exp.Operations() {
-		nextTerm := exp.Terms()[i+1]
-		nextAddr, err := generateCodeTerm(nextTerm, ctx, fe)
+
+	for i, op := range eq.Operations() {
+		next := eq.RelationalExps()[i+1]
+		nextAddr, err := generateCodeRelationalExp(next, ctx, fe)
 		if err != nil {
 			return mem.Address(-1), err
 		}
 
-		prevType := ctx.gen.GetFromTypeStack()
-		nextType := ctx.gen.GetFromTypeStack()
-
-		semCubeKey := semantics.GetSemanticCubeKey(op, []*types.Type{prevType, nextType})
-		basic, ok := ctx.SemCube().Get(semCubeKey)
-		if !ok {
-			return mem.Address(-1), errutil.Newf("%+v: Invalid operation %v %s %v", nextTerm.Token(), prevType, op, nextType)
+		prevAddr, err = generateCodeOperation(op, prevAddr, nextAddr, next.Token(), ctx)
+		if err != nil {
+			return mem.Address(-1), err
 		}
+	}
 
-		genType := types.NewDataType(basic, 0, 0)
+	return prevAddr, nil
+}
+
+func generateCodeRelationalExp(rel *ast.RelationalExp, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
+	prevAddr, err := generateCodeExp(rel.Exps()[0], ctx, fe)
+	if err != nil {
+		return mem.Address(-1), err
+	}
 
-		tmp, err := ctx.vm.GetNextTemp(genType)
+	for i, op := range rel.Operations() {
+		next := rel.Exps()[i+1]
+		nextAddr, err := generateCodeExp(next, ctx, fe)
 		if err != nil {
 			return mem.Address(-1), err
 		}
 
-		if err := generateOperationQuad(op, prevAddr, nextAddr, tmp, ctx); err != nil {
+		prevAddr, err = generateCodeOperation(op, prevAddr, nextAddr, next.Token(), ctx)
+		if err != nil {
 			return mem.Address(-1), err
 		}
-
-		prevAddr = tmp
-		prevTerm = nextTerm
-
-		ctx.gen.PushToTypeStack(genType)
 	}
 
 	return prevAddr, nil
 }
 
-func generateCodeTerm(term *ast.Term, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
-	prevFactor := term.Factors()[0]
-	prevAddr, err := generateCodeFactor(prevFactor, ctx, fe)
+func generateCodeExp(exp *ast.Exp, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
+	prevAddr, err := generateCodeTerm(exp.Terms()[0], ctx, fe)
 	if err != nil {
 		return mem.Address(-1), err
 	}
-	
-	for i, op := range term.Operations() {
-		nextFactor := term.Factors()[i+1]
-		nextAddr, err := generateCodeFactor(nextFactor, ctx, fe)
+
+	for i, op := range exp.Operations() {
+		next := exp.Terms()[i+1]
+		nextAddr, err := generateCodeTerm(next, ctx, fe)
 		if err != nil {
 			return mem.Address(-1), err
 		}
 
-		prevType := ctx.gen.GetFromTypeStack()
-		nextType := ctx.gen.GetFromTypeStack()
-
-		semCubeKey := semantics.GetSemanticCubeKey(op, []*types.Type{prevType, nextType})
-		basic, ok := ctx.SemCube().Get(semCubeKey)
-		if !ok {
-			return mem.Address(-1), errutil.Newf("%+v: Invalid operation %v %s %v", nextFactor.Token(), prevType, op, nextType)
+		prevAddr, err = generateCodeOperation(op, prevAddr, nextAddr, next.Token(), ctx)
+		if err != nil {
+			return mem.Address(-1), err
 		}
+	}
 
-		genType := types.NewDataType(basic, 0, 0)
+	return prevAddr, nil
+}
 
-		tmp, err:= ctx.vm.GetNextTemp(genType)
+func generateCodeTerm(term *ast.Term, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
+	prevAddr, err := generateCodeFactor(term.Factors()[0], ctx, fe)
+	if err != nil {
+		return mem.Address(-1), err
+	}
+
+	for i, op := range term.Operations() {
+		next := term.Factors()[i+1]
+		nextAddr, err := generateCodeFactor(next, ctx, fe)
 		if err != nil {
 			return mem.Address(-1), err
 		}
 
-		if err := generateOperationQuad(op, prevAddr, nextAddr, tmp, ctx); err != nil {
+		prevAddr, err = generateCodeOperation(op, prevAddr, nextAddr, next.Token(), ctx)
+		if err != nil {
 			return mem.Address(-1), err
 		}
-
-		prevAddr = tmp
-		prevFactor = nextFactor
-
-		ctx.gen.PushToTypeStack(genType)
 	}
 
 	return prevAddr, nil
@@ -414,32 +871,159 @@ func generateCodeFactor(factor *ast.Factor, ctx *GenerationContext, fe *director
 		return expAddr, nil
 	}
 
+	if factor.Unary() != nil {
+		return generateCodeUnary(factor.Unary(), ctx, fe)
+	}
+
 	return generateCodeConstant(factor.Constant(), ctx, fe)
 }
 
+func generateCodeUnary(unary *ast.Unary, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
+	addr, err := generateCodeFactor(unary.Factor(), ctx, fe)
+	if err != nil {
+		return mem.Address(-1), err
+	}
+
+	t := ctx.gen.GetFromTypeStack()
+
+	semCubeKey := semantics.GetSemanticCubeKey(unary.Operation(), []*types.Type{t})
+	basic, ok := ctx.SemCube().Get(semCubeKey)
+	if !ok {
+		return mem.Address(-1), errutil.Newf("%+v: Invalid operation %s%v", unary.Token(), unary.Operation(), t)
+	}
+
+	genType := types.NewDataType(basic, 0, 0)
+
+	tmp, err := ctx.vm.GetNextTemp(genType)
+	if err != nil {
+		return mem.Address(-1), err
+	}
+
+	prevPos := ctx.gen.Position()
+	ctx.gen.SetPosition(unary.Token().Pos)
+	defer ctx.gen.SetPosition(prevPos)
+
+	switch unary.Operation() {
+	case "!":
+		ctx.gen.Generate(quad.Not, addr, mem.Address(-1), tmp)
+	case "-":
+		ctx.gen.Generate(quad.Neg, addr, mem.Address(-1), tmp)
+	default:
+		return mem.Address(-1), errutil.Newf("Cannot get valid unary operation %s", unary.Operation())
+	}
+
+	ctx.gen.PushToTypeStack(genType)
+	return tmp, nil
+}
+
 func generateCodeConstant(c ast.Constant, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
+	// Function calls and indexing of lists are located at their id
+	prev := ctx.gen.Position()
+	ctx.gen.SetPosition(c.Token().Pos)
+	defer ctx.gen.SetPosition(prev)
+
 	if cv, ok := c.(*ast.ConstantValue); ok {
 		return generateCodeConstantValue(cv, ctx, fe)
 	} else if att, ok := c.(*ast.Attribute); ok {
 		return generateCodeAttributeConstant(att, ctx, fe)
 	} else if fc, ok := c.(*ast.FunctionCall); ok {
 		return generateCodeFunctionCall(fc, ctx, fe)
+	} else if mc, ok := c.(*ast.ClassFunctionCall); ok {
+		return generateCodeMethodCall(mc, ctx, fe)
 	} else if le, ok := c.(*ast.ListElem); ok {
 		return generateCodeListElem(le, ctx, fe)
+	} else if cast, ok := c.(*ast.Cast); ok {
+		return generateCodeCast(cast, ctx, fe)
+	} else if ol, ok := c.(*ast.ObjectLiteral); ok {
+		return generateCodeObjectLiteral(ol, ctx, fe)
 	}
 
 	return mem.Address(-1), errutil.Newf("Cannot cast constant to any valid form %+v", c.Token())
 }
 
+// generateCodeCast generates the conversion of the expression of a cast, a cast to the same type does nothing
+func generateCodeCast(c *ast.Cast, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
+	addr, err := generateCodeExpression(c.Expression(), ctx, fe)
+	if err != nil {
+		return mem.Address(-1), err
+	}
+	from := ctx.gen.GetFromTypeStack()
+
+	ctx.gen.PushToTypeStack(c.Type())
+	if from.Equal(c.Type()) {
+		return addr, nil
+	}
+
+	var op quad.Operation
+	switch {
+	case from.Basic() == types.Int && c.Type().Basic() == types.Float:
+		op = quad.IntToFloat
+	case from.Basic() == types.Float && c.Type().Basic() == types.Int:
+		op = quad.FloatToInt
+	case from.Basic() == types.Int && c.Type().Basic() == types.Char:
+		op = quad.IntToChar
+	case from.Basic() == types.Char && c.Type().Basic() == types.Int:
+		op = quad.CharToInt
+	default:
+		return mem.Address(-1), errutil.Newf("%+v: Cannot cast %s to %s", c.Token(), from.Name(), c.Type().Name())
+	}
+
+	tmp, err := ctx.vm.GetNextTemp(c.Type())
+	if err != nil {
+		return mem.Address(-1), err
+	}
+
+	ctx.gen.Generate(op, addr, mem.Address(-1), tmp)
+	return tmp, nil
+}
+
+// generateCodeObjectLiteral initializes a temporary object and stores the value of every attribute set in it
+func generateCodeObjectLiteral(ol *ast.ObjectLiteral, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
+	typeint, err := strconv.ParseInt(ol.Type().String(), 16, 64)
+	if err != nil {
+		return mem.Address(-1), err
+	}
+
+	objTmp, err := ctx.vm.GetNextTemp(ol.Type())
+	if err != nil {
+		return mem.Address(-1), err
+	}
+	ctx.gen.Generate(quad.Init, objTmp, mem.Address(1), mem.Address(typeint))
+
+	for _, fi := range ol.Fields() {
+		addr, err := generateCodeExpression(fi.Expression(), ctx, fe)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+		t := ctx.gen.GetFromTypeStack()
+
+		addr, err = generateCodePromotion(addr, t, semantics.GetObjectAttributeType(fi.Id()), ctx)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+
+		ctx.gen.Generate(quad.Assign, addr, mem.Address(-1), mem.Address(int(objTmp) + types.GetAttributeOffset(fi.Id())))
+	}
+
+	ctx.gen.PushToTypeStack(ol.Type())
+	return objTmp, nil
+}
+
 func generateCodeConstantValue(cv *ast.ConstantValue, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
 	ctx.gen.PushToTypeStack(cv.Type())
-	return ctx.vm.GetConstantAddress(cv.Value()), nil
+	return ctx.vm.GetConstantAddress(mem.ConstantKey(cv.Value(), cv.Type())), nil
 }
 
 func generateCodeFunctionCall(fc *ast.FunctionCall, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
 	currFe := ctx.FuncDir().Get(fc.Id())
 
 	if currFe == nil {
+		if semantics.IsListFunction(fc.Id()) {
+			return generateCodeListFunction(fc, ctx, fe)
+		}
+		if semantics.IsStringFunction(fc.Id()) {
+			return generateCodeStringFunction(fc, ctx, fe)
+		}
 		if semantics.IdIsReserved(fc.Id()) {
 			return generateCodeFunctionCallReserved(fc, ctx, fe)
 		}
@@ -448,7 +1032,7 @@ func generateCodeFunctionCall(fc *ast.FunctionCall, ctx *GenerationContext, fe *
 
 	ctx.gen.Generate(quad.Era, mem.Address(-1), mem.Address(-1), mem.Address(-1))
 
-	for _, e := range fc.Params() {
+	for i, e := range fc.Params() {
 		tmp, err := generateCodeExpression(e, ctx, fe)
 
 		expType := ctx.gen.GetFromTypeStack()
@@ -456,8 +1040,13 @@ func generateCodeFunctionCall(fc *ast.FunctionCall, ctx *GenerationContext, fe *
 			return mem.Address(-1), err
 		}
 
+		tmp, err = generateCodePromotion(tmp, expType, currFe.Params()[i], ctx)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+
 		if expType.List() > 0 {
-			ctx.gen.Generate(quad.Param, tmp, mem.Address(expType.Size()), mem.Address(-1))
+			ctx.gen.Generate(quad.Param, tmp, mem.Address(expType.Elements()), mem.Address(-1))
 		} else {
 			ctx.gen.Generate(quad.Param, tmp, mem.Address(-1), mem.Address(-1))
 		}
@@ -477,6 +1066,147 @@ func generateCodeFunctionCall(fc *ast.FunctionCall, ctx *GenerationContext, fe *
 	return rettmp, nil
 }
 
+// generateCodeMethodCall calls the function of the method, the object is passed before the arguments
+// with a This quad so the changes to it are kept after the call
+func generateCodeMethodCall(mc *ast.ClassFunctionCall, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
+	ve := fe.VarDir().Get(mc.Obj())
+	if ve == nil {
+		ve = ctx.Globals().Get(mc.Obj())
+		if ve == nil {
+			return mem.Address(-1), errutil.Newf("Id %s could not be found in local or global scope. (check scopecheck?)", mc.Obj())
+		}
+	}
+
+	key := ve.Type().Struct().Name() + "." + mc.Id()
+	currFe := ctx.FuncDir().Get(key)
+	if currFe == nil {
+		return mem.Address(-1), errutil.Newf("Cannot find method %s in FuncDirectory", key)
+	}
+
+	ctx.gen.Generate(quad.Era, mem.Address(-1), mem.Address(-1), mem.Address(-1))
+	ctx.gen.Generate(quad.This, ve.Address(), mem.Address(-1), mem.Address(-1))
+
+	for i, e := range mc.Params() {
+		tmp, err := generateCodeExpression(e, ctx, fe)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+
+		// The first parameter of the method is the object
+		expType := ctx.gen.GetFromTypeStack()
+		tmp, err = generateCodePromotion(tmp, expType, currFe.Params()[i+1], ctx)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+		if expType.List() > 0 {
+			ctx.gen.Generate(quad.Param, tmp, mem.Address(expType.Elements()), mem.Address(-1))
+		} else {
+			ctx.gen.Generate(quad.Param, tmp, mem.Address(-1), mem.Address(-1))
+		}
+	}
+
+	rettmp, err := ctx.vm.GetNextTemp(currFe.ReturnType())
+	if err != nil {
+		return mem.Address(-1), err
+	}
+
+	ctx.gen.AddPendingFuncAddr(ctx.gen.ICounter(), currFe.Id())
+	ctx.gen.Generate(quad.Call, mem.Address(-1), mem.Address(-1), rettmp)
+
+	ctx.gen.PushToTypeStack(currFe.ReturnType())
+
+	return rettmp, nil
+}
+
+// generateCodeListFunction generates the quad of a builtin of the lists, the list is always the first argument
+func generateCodeListFunction(fc *ast.FunctionCall, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
+	addresses := make([]mem.Address, 0, len(fc.Params()))
+	argTypes := make([]*types.Type, 0, len(fc.Params()))
+	for _, e := range fc.Params() {
+		tmp, err := generateCodeExpression(e, ctx, fe)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+
+		argTypes = append(argTypes, ctx.gen.GetFromTypeStack())
+		addresses = append(addresses, tmp)
+	}
+
+	rettype := semantics.GetListFunctionReturnType(fc.Id())
+	result := mem.Address(-1)
+
+	switch fc.Id() {
+	case "append":
+		ctx.gen.Generate(quad.ListAppend, addresses[1], mem.Address(-1), addresses[0])
+	case "len":
+		tmp, err := ctx.vm.GetNextTemp(rettype)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+		// len also counts the characters of a string
+		if argTypes[0].IsDynamic() {
+			ctx.gen.Generate(quad.ListLen, addresses[0], mem.Address(-1), tmp)
+		} else {
+			ctx.gen.Generate(quad.StrLen, addresses[0], mem.Address(-1), tmp)
+		}
+		result = tmp
+	case "remove":
+		ctx.gen.Generate(quad.ListRemove, addresses[0], addresses[1], mem.Address(-1))
+	case "clear":
+		ctx.gen.Generate(quad.ListClear, addresses[0], mem.Address(-1), mem.Address(-1))
+	default:
+		return mem.Address(-1), errutil.Newf("Cannot find list function %s", fc.Id())
+	}
+
+	ctx.gen.PushToTypeStack(rettype)
+	return result, nil
+}
+
+// generateCodeStringFunction generates the quad of a builtin of the strings, the start and the length
+// of substr are copied to two cells next to each other so they fit in a single operand
+func generateCodeStringFunction(fc *ast.FunctionCall, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
+	addresses := make([]mem.Address, 0, len(fc.Params()))
+	for _, e := range fc.Params() {
+		tmp, err := generateCodeExpression(e, ctx, fe)
+		if err != nil {
+			return mem.Address(-1), err
+		}
+		_ = ctx.gen.GetFromTypeStack()
+
+		addresses = append(addresses, tmp)
+	}
+
+	rettype := semantics.GetStringFunctionReturnType(fc.Id())
+	result, err := ctx.vm.GetNextTemp(rettype)
+	if err != nil {
+		return mem.Address(-1), err
+	}
+
+	switch fc.Id() {
+	case "substr":
+		bounds, err := ctx.vm.GetNextTemp(types.NewDataType(types.Int, 1, 2))
+		if err != nil {
+			return mem.Address(-1), err
+		}
+		ctx.gen.Generate(quad.Assign, addresses[1], mem.Address(-1), bounds)
+		ctx.gen.Generate(quad.Assign, addresses[2], mem.Address(-1), bounds+1)
+		ctx.gen.Generate(quad.Substr, addresses[0], bounds, result)
+	case "indexOf":
+		ctx.gen.Generate(quad.IndexOf, addresses[0], addresses[1], result)
+	case "toString":
+		ctx.gen.Generate(quad.ToString, addresses[0], mem.Address(-1), result)
+	case "parseInt":
+		ctx.gen.Generate(quad.ParseInt, addresses[0], mem.Address(-1), result)
+	case "parseFloat":
+		ctx.gen.Generate(quad.ParseFloat, addresses[0], mem.Address(-1), result)
+	default:
+		return mem.Address(-1), errutil.Newf("Cannot find string function %s", fc.Id())
+	}
+
+	ctx.gen.PushToTypeStack(rettype)
+	return result, nil
+}
+
 func generateCodeFunctionCallReserved(fc *ast.FunctionCall, ctx *GenerationContext, fe *directories.FuncEntry) (mem.Address, error) {
 	rettype := semantics.GetReservedReturnType(fc.Id())
 
@@ -494,6 +1224,11 @@ func generateCodeFunctionCallReserved(fc *ast.FunctionCall, ctx *GenerationConte
 			return mem.Address(-1), errutil.Newf("Cannot call function %s with argument of type array", fc.Id())
 		}
 
+		// A class is passed as the object it embeds
+		if expType.IsStruct() {
+			tmp = mem.Address(int(tmp) + expType.Struct().Embedded().Offset())
+		}
+
 		addresses[i] = tmp
 	}
 
@@ -504,6 +1239,7 @@ func generateCodeFunctionCallReserved(fc *ast.FunctionCall, ctx *GenerationConte
 
 	ctx.gen.Generate(quad.GetOperation(fc.Id()), addresses[0], addresses[1], retTmp)
 
+	ctx.gen.PushToTypeStack(rettype)
 	return retTmp, nil
 }
 
@@ -540,9 +1276,87 @@ func generateCodeCondition(cond *ast.Condition, ctx *GenerationContext, fe *dire
 	return nil
 }
 
+// generateCodeSwitch compares the expression with the values of every case, jumping to the statements of
+// the first one that is equal. If none is, it jumps to the default case or to the end of the switch
+func generateCodeSwitch(sw *ast.Switch, ctx *GenerationContext, fe *directories.FuncEntry) error {
+	exp, err := generateCodeExpression(sw.Expression(), ctx, fe)
+	if err != nil {
+		return err
+	}
+	_ = ctx.gen.GetFromTypeStack()
+
+	// Location of the GotoT of every value of each case
+	jumps := make([][]mem.Address, len(sw.Cases()))
+	for i, c := range sw.Cases() {
+		for _, v := range c.Values() {
+			addr, err := generateCodeFactor(v, ctx, fe)
+			if err != nil {
+				return err
+			}
+			_ = ctx.gen.GetFromTypeStack()
+
+			tmp, err := ctx.vm.GetNextTemp(types.NewDataType(types.Bool, 0, 0))
+			if err != nil {
+				return err
+			}
+
+			prevPos := ctx.gen.Position()
+			ctx.gen.SetPosition(v.Token().Pos)
+			ctx.gen.Generate(quad.Equal, exp, addr, tmp)
+			jumps[i] = append(jumps[i], mem.Address(ctx.gen.ICounter()))
+			ctx.gen.Generate(quad.GotoT, tmp, mem.Address(-1), mem.Address(-1))
+			ctx.gen.SetPosition(prevPos)
+		}
+	}
+
+	noMatch := mem.Address(ctx.gen.ICounter())
+	ctx.gen.Generate(quad.Goto, mem.Address(-1), mem.Address(-1), mem.Address(-1))
+
+	ctx.gen.PushSwitch()
+	ends := make([]mem.Address, 0)
+	hasDefault := false
+	for i, c := range sw.Cases() {
+		start := mem.Address(ctx.gen.ICounter())
+		for _, j := range jumps[i] {
+			ctx.gen.FillJumpQuadruple(j, start)
+		}
+		if c.IsDefault() {
+			ctx.gen.FillJumpQuadruple(noMatch, start)
+			hasDefault = true
+		}
+
+		for _, s := range c.Statements() {
+			if err := generateCodeStatement(s, ctx, fe); err != nil {
+				return err
+			}
+		}
+
+		// Cases do not fall through to the next one
+		if i < len(sw.Cases())-1 {
+			ends = append(ends, mem.Address(ctx.gen.ICounter()))
+			ctx.gen.Generate(quad.Goto, mem.Address(-1), mem.Address(-1), mem.Address(-1))
+		}
+	}
+	loop := ctx.gen.GetFromLoopStack()
+
+	end := mem.Address(ctx.gen.ICounter())
+	if !hasDefault {
+		ctx.gen.FillJumpQuadruple(noMatch, end)
+	}
+	fillLoopJumps(ends, end, ctx)
+	fillLoopJumps(loop.Breaks(), end, ctx)
+
+	return nil
+}
+
 func generateCodeReturn(ret *ast.Return, ctx *GenerationContext, fe *directories.FuncEntry) error {
 	expTmp, err := generateCodeExpression(ret.Expression(), ctx, fe)
-	_ = ctx.gen.GetFromTypeStack() // Remove unused type from gen expression
+	if err != nil {
+		return err
+	}
+	expType := ctx.gen.GetFromTypeStack()
+
+	expTmp, err = generateCodePromotion(expTmp, expType, fe.ReturnType(), ctx)
 	if err != nil {
 		return err
 	}
@@ -579,12 +1393,16 @@ func generateCodeFor(f *ast.For, ctx *GenerationContext, fe *directories.FuncEnt
 	ctx.gen.PushToJumpStack(mem.Address(ctx.gen.ICounter())) // address gotof
 	ctx.gen.Generate(quad.GotoF, expTmp, mem.Address(-1) , mem.Address(-1))
 	
+	ctx.gen.PushLoop()
 	for _, s := range f.Block() {
 		if err := generateCodeStatement(s, ctx, fe); err != nil {
 			return err
 		}
 	}
+	loop := ctx.gen.GetFromLoopStack()
 
+	// A continue still executes the operation of the for before checking the condition
+	fillLoopJumps(loop.Continues(), mem.Address(ctx.gen.ICounter()), ctx)
 	if err := generateCodeAssign(f.Operation(), ctx, fe); err != nil {
 		return err
 	}
@@ -594,6 +1412,7 @@ func generateCodeFor(f *ast.For, ctx *GenerationContext, fe *directories.FuncEnt
 
 	ctx.gen.Generate(quad.Goto, mem.Address(-1) , mem.Address(-1) , COND)
 	ctx.gen.FillJumpQuadruple(GOTOF, mem.Address(ctx.gen.ICounter()))
+	fillLoopJumps(loop.Breaks(), mem.Address(ctx.gen.ICounter()), ctx)
 
 	return nil
 }
@@ -609,21 +1428,54 @@ func generateCodeWhile(w *ast.While, ctx *GenerationContext, fe *directories.Fun
 	ctx.gen.PushToJumpStack(mem.Address(ctx.gen.ICounter())) // address gotof
 	ctx.gen.Generate(quad.GotoF, expTmp, mem.Address(-1) , mem.Address(-1))
 	
+	ctx.gen.PushLoop()
 	for _, s := range w.Block() {
 		if err := generateCodeStatement(s, ctx, fe); err != nil {
 			return err
 		}
 	}
+	loop := ctx.gen.GetFromLoopStack()
 
 	GOTOF := ctx.gen.GetFromJumpStack()
 	WHILE := ctx.gen.GetFromJumpStack()
 
 	ctx.gen.Generate(quad.Goto, mem.Address(-1), mem.Address(-1) , WHILE)
 	ctx.gen.FillJumpQuadruple(GOTOF, mem.Address(ctx.gen.ICounter()))
+	fillLoopJumps(loop.Continues(), WHILE, ctx)
+	fillLoopJumps(loop.Breaks(), mem.Address(ctx.gen.ICounter()), ctx)
 	
 	return nil
 }
 
+// fillLoopJumps sets the destination of the gotos of the breaks or continues of a loop
+func fillLoopJumps(locs []mem.Address, jump mem.Address, ctx *GenerationContext) {
+	for _, loc := range locs {
+		ctx.gen.FillJumpQuadruple(loc, jump)
+	}
+}
+
+func generateCodeBreak(ctx *GenerationContext) error {
+	loop := ctx.gen.CurrentBreakable()
+	if loop == nil {
+		return errutil.Newf("Break outside of a loop or switch")
+	}
+
+	loop.AddBreak(mem.Address(ctx.gen.ICounter()))
+	ctx.gen.Generate(quad.Goto, mem.Address(-1), mem.Address(-1), mem.Address(-1))
+	return nil
+}
+
+func generateCodeContinue(ctx *GenerationContext) error {
+	loop := ctx.gen.CurrentLoop()
+	if loop == nil {
+		return errutil.Newf("Continue outside of a loop")
+	}
+
+	loop.AddContinue(mem.Address(ctx.gen.ICounter()))
+	ctx.gen.Generate(quad.Goto, mem.Address(-1), mem.Address(-1), mem.Address(-1))
+	return nil
+}
+
 func generateOperationQuad(op string, lop, rop, res mem.Address, ctx *GenerationContext) error {
 	switch op {
 	case "+":
@@ -634,12 +1486,20 @@ func generateOperationQuad(op string, lop, rop, res mem.Address, ctx *Generation
 		ctx.gen.Generate(quad.Mult, lop, rop, res)
 	case "/":
 		ctx.gen.Generate(quad.Div, lop, rop, res)
+	case "%":
+		ctx.gen.Generate(quad.Mod, lop, rop, res)
 	case "<":
 		ctx.gen.Generate(quad.Lt, lop, rop, res)
 	case ">":
 		ctx.gen.Generate(quad.Gt, lop, rop, res)
+	case "<=":
+		ctx.gen.Generate(quad.LtEqual, lop, rop, res)
+	case ">=":
+		ctx.gen.Generate(quad.GtEqual, lop, rop, res)
 	case "==":
 		ctx.gen.Generate(quad.Equal, lop, rop, res)
+	case "!=":
+		ctx.gen.Generate(quad.NotEqual, lop, rop, res)
 	case "&&":
 		ctx.gen.Generate(quad.And, lop, rop, res)
 	case "||":
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/sdkvictor/golang-compiler/directories"
+	"github.com/sdkvictor/golang-compiler/gocc/token"
 	"github.com/sdkvictor/golang-compiler/mem"
 	"github.com/sdkvictor/golang-compiler/quad"
 	"github.com/sdkvictor/golang-compiler/types"
@@ -16,16 +17,19 @@ type Generator struct {
 	jumpStack       *AddressStack
 	addrStack       *AddressStack
 	typeStack		*TypeStack
+	loopStack       *LoopStack
 	icounter        int
 	pcounter        int
 	quads           []*quad.Quadruple
+	positions       []token.Pos
+	pos             token.Pos
 	pendingFuncAddr map[int]string
 	pendingEraSize  map[int]string
 }
 
 // NewGenerator ...
 func NewGenerator() *Generator {
-	return &Generator{NewAddressStack(), NewAddressStack(), NewTypeStack(), 0, 0, make([]*quad.Quadruple, 0), make(map[int]string), make(map[int]string)}
+	return &Generator{NewAddressStack(), NewAddressStack(), NewTypeStack(), NewLoopStack(), 0, 0, make([]*quad.Quadruple, 0), make([]token.Pos, 0), token.Pos{}, make(map[int]string), make(map[int]string)}
 }
 
 // JumpStack ...
@@ -55,9 +59,26 @@ func (g *Generator) Quadruples() []*quad.Quadruple {
 	return g.quads
 }
 
+// Positions returns the source position of every quadruple, the line is 0 for the ones that
+// do not come from the source
+func (g *Generator) Positions() []token.Pos {
+	return g.positions
+}
+
+// Position gets the source position given to the quadruples that are generated
+func (g *Generator) Position() token.Pos {
+	return g.pos
+}
+
+// SetPosition changes the source position given to the next quadruples
+func (g *Generator) SetPosition(pos token.Pos) {
+	g.pos = pos
+}
+
 // Generate creates a new quadruple with the given parameters
 func (g *Generator) Generate(op quad.Operation, a1, a2, r mem.Address) {
 	g.quads = append(g.quads, quad.NewQuadruple(op, a1, a2, r))
+	g.positions = append(g.positions, g.pos)
 	//fmt.Printf("%d: Operation: %s %d %d %d\n", g.icounter, op, a1, a2, r)
 	g.icounter++
 }
@@ -98,6 +119,33 @@ func (g *Generator) GetFromTypeStack() *types.Type {
 	return val
 }
 
+//PushLoop starts the context of a loop, where its breaks and continues are added
+func (g *Generator) PushLoop() {
+	g.loopStack.Push(NewLoopContext(false))
+}
+
+//PushSwitch starts the context of a switch, where its breaks are added
+func (g *Generator) PushSwitch() {
+	g.loopStack.Push(NewLoopContext(true))
+}
+
+//GetFromLoopStack removes the context of the innermost loop and returns it
+func (g *Generator) GetFromLoopStack() *LoopContext {
+	val := g.loopStack.Top()
+	g.loopStack.Pop()
+	return val
+}
+
+//CurrentBreakable returns the context of the innermost loop or switch, nil if the code is not inside one
+func (g *Generator) CurrentBreakable() *LoopContext {
+	return g.loopStack.Top()
+}
+
+//CurrentLoop returns the context of the innermost loop, nil if the code is not inside a loop
+func (g *Generator) CurrentLoop() *LoopContext {
+	return g.loopStack.Loop()
+}
+
 //FillJumpQuadruple ...
 func (g *Generator) FillJumpQuadruple(location mem.Address, jump mem.Address) {
 	g.quads[int(location)].SetR(jump)
@@ -161,6 +209,7 @@ func (g *Generator) String() string {
 	return builder.String()
 }
 
+// CreateFile writes the quads and the constants of the program to <file>.obj, which is read back by vm.ParseObject and vm.LoadProgram
 func (g *Generator) CreateFile(file string, vm *mem.VirtualMemory) error {
 	var builder strings.Builder
 
@@ -178,7 +227,7 @@ func (g *Generator) CreateFile(file string, vm *mem.VirtualMemory) error {
 	}
 
 	content := []byte(builder.String())
-	path := fmt.Sprintf("./%s.obj", file)
+	path := fmt.Sprintf("%s.obj", file)
 
 	err := ioutil.WriteFile(path, content, 0644)
 
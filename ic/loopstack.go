@@ -0,0 +1,97 @@
+package ic
+
+import (
+	"github.com/sdkvictor/golang-compiler/mem"
+)
+
+// LoopContext keeps the jumps of the break and continue statements of a loop, they are filled once the
+// loop is generated and the addresses they go to are known. A switch also has a context for its breaks
+type LoopContext struct {
+	breaks    []mem.Address
+	continues []mem.Address
+	isSwitch  bool
+}
+
+// IsSwitch tells if the context is of a switch, which can be left with break but not continued
+func (l *LoopContext) IsSwitch() bool {
+	return l.isSwitch
+}
+
+// AddBreak adds the location of the goto of a break
+func (l *LoopContext) AddBreak(loc mem.Address) {
+	l.breaks = append(l.breaks, loc)
+}
+
+// AddContinue adds the location of the goto of a continue
+func (l *LoopContext) AddContinue(loc mem.Address) {
+	l.continues = append(l.continues, loc)
+}
+
+// Breaks returns the locations of the gotos of every break of the loop
+func (l *LoopContext) Breaks() []mem.Address {
+	return l.breaks
+}
+
+// Continues returns the locations of the gotos of every continue of the loop
+func (l *LoopContext) Continues() []mem.Address {
+	return l.continues
+}
+
+// NewLoopContext ...
+func NewLoopContext(isSwitch bool) *LoopContext {
+	return &LoopContext{make([]mem.Address, 0), make([]mem.Address, 0), isSwitch}
+}
+
+// nodel is a data container
+type nodel struct {
+	val  *LoopContext
+	next *nodel
+}
+
+// LoopStack implements a stack for the loops that are being generated, the innermost one is on top
+type LoopStack struct {
+	head *nodel
+}
+
+// Empty returns true if LoopStack is empty
+func (s *LoopStack) Empty() bool {
+	return s.head == nil
+}
+
+// Pop removes the first element in the container
+func (s *LoopStack) Pop() {
+	if s.Empty() {
+		return
+	}
+
+	s.head = s.head.next
+}
+
+// Top returns the first element in the container
+func (s *LoopStack) Top() *LoopContext {
+	if s.Empty() {
+		return nil
+	}
+	return s.head.val
+}
+
+// Loop returns the innermost context that is not of a switch, nil if there is none
+func (s *LoopStack) Loop() *LoopContext {
+	for curr := s.head; curr != nil; curr = curr.next {
+		if !curr.val.isSwitch {
+			return curr.val
+		}
+	}
+	return nil
+}
+
+// Push adds an element to the top of the container
+func (s *LoopStack) Push(val *LoopContext) {
+	newHead := &nodel{val, s.head}
+	s.head = newHead
+}
+
+// NewLoopStack ...
+func NewLoopStack() *LoopStack {
+	return &LoopStack{nil}
+}
@@ -2,6 +2,7 @@ package mem
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/sdkvictor/golang-compiler/types"
@@ -12,10 +13,10 @@ type Address int
 
 //Constants that mark the start of the context
 const Globalstart = 0
-const Localstart = 10000
-const Tempstart = 20000
-const Constantstart = 30000
-const Scopestart = 40000
+const Localstart = 20000
+const Tempstart = 40000
+const Constantstart = 60000
+const Scopestart = 80000
 
 //Constants that set the offset of the segment
 const FloatOffset = 0
@@ -28,6 +29,8 @@ const CircleOffset = 6000
 const ImageOffset = 7000
 const TextOffset = 8000
 const BackgroundOffset = 9000
+const StructOffset = 10000
+const ListOffset = 11000
 
 //Constant that defines de size of the segment
 const segmentsize = 1000
@@ -46,34 +49,34 @@ var DefaultConstants = map[string]string{
 	"2": "a",
 	"3": "false",
 	"4": "0",
-	"5": "-",
+	"5": "\"-\"",
+}
+
+// ConstantKey is the key of a constant in the constant map. A string is quoted, so it does not share an
+// address with a constant of another type and its escapes keep it in a single line of the object file
+func ConstantKey(c string, t *types.Type) string {
+	if t.Equal(types.NewDataType(types.String, 0, 0)) {
+		return strconv.Quote(c)
+	}
+	return c
 }
 
 /*
-Floats -> Chars -> Booleans -> Ints -> Strings -> Squares -> Circles -> Images -> Texts -> Backgrounds
+Floats -> Chars -> Booleans -> Ints -> Strings -> Squares -> Circles -> Images -> Texts -> Backgrounds -> Structs
 ========Global = 0
-0-999     Float
-1000-1999 Char
-2000-2999 Bool
-3000-3999 Int
-4000-4999 String
-5000-5999 Square
-6000-6999 Circle
-7000-7999 Image
-8000-8999 Text
-9000-9999 Background
-========Local = 10000
-10000-10999 Float
-11000-11999 Char
-12000-12999 Bool
-13000-13999 Int
-14000-14999 String
-15000-15999 Square
-16000-16999 Circle
-17000-17999 Image
-18000-18999 Text
-19000-19999 Background
-======= Temp = 20000
+0-999       Float
+1000-1999   Char
+2000-2999   Bool
+3000-3999   Int
+4000-4999   String
+5000-5999   Square
+6000-6999   Circle
+7000-7999   Image
+8000-8999   Text
+9000-9999   Background
+10000-10999 Struct
+11000-11999 List
+========Local = 20000
 20000-20999 Float
 21000-21999 Char
 22000-22999 Bool
@@ -84,19 +87,36 @@ Floats -> Chars -> Booleans -> Ints -> Strings -> Squares -> Circles -> Images -
 27000-27999 Image
 28000-28999 Text
 29000-29999 Background
-========Constant = 30000
-30000-30999 Float
-31000-31999 Char
-32000-32999 Bool
-33000-33999 Int
-34000-34999 String
-35000-35999 Square
-36000-36999 Circle
-37000-37999 Image
-38000-38999 Text
-39000-39999 Background
+30000-30999 Struct
+31000-31999 List
+======= Temp = 40000
+40000-40999 Float
+41000-41999 Char
+42000-42999 Bool
+43000-43999 Int
+44000-44999 String
+45000-45999 Square
+46000-46999 Circle
+47000-47999 Image
+48000-48999 Text
+49000-49999 Background
+50000-50999 Struct
+51000-51999 List
+========Constant = 60000
+60000-60999 Float
+61000-61999 Char
+62000-62999 Bool
+63000-63999 Int
+64000-64999 String
+65000-65999 Square
+66000-66999 Circle
+67000-67999 Image
+68000-68999 Text
+69000-69999 Background
+
+A struct takes one cell for its header followed by a cell for each of its fields
+A list takes a single cell, its elements are stored in the heap of the virtual machine
 */
-
 var objectSize = len(types.ObjectAttributesIndex)+1
 
 //Struct that defines what the Virtual memory containts
@@ -111,6 +131,8 @@ type VirtualMemory struct {
 	globalimagecount      int
 	globaltextcount       int
 	globalbackgroundcount int
+	globalstructcount     int
+	globallistcount       int
 
 	localfloatcount      int
 	localcharcount       int
@@ -122,6 +144,8 @@ type VirtualMemory struct {
 	localimagecount      int
 	localtextcount       int
 	localbackgroundcount int
+	localstructcount     int
+	locallistcount       int
 
 	tempfloatcount      int
 	tempcharcount       int
@@ -133,6 +157,8 @@ type VirtualMemory struct {
 	tempimagecount      int
 	temptextcount       int
 	tempbackgroundcount int
+	tempstructcount     int
+	templistcount       int
 
 	constantfloatcount      int
 	constantcharcount       int
@@ -173,6 +199,8 @@ func NewVirtualMemory() *VirtualMemory {
 		0, // globalimagecount
 		0, // globaltextcount
 		0, // globalbackgroundcount
+		0, // globalstructcount
+		0, // globallistcount
 
 		0, // localfloatcount
 		0, // localcharcount
@@ -184,6 +212,8 @@ func NewVirtualMemory() *VirtualMemory {
 		0, // localimagecount
 		0, // localtextcount
 		0, // localbackgroundcount
+		0, // localstructcount
+		0, // locallistcount
 
 		0, // tempfloatcount
 		0, // tempcharcount
@@ -195,6 +225,8 @@ func NewVirtualMemory() *VirtualMemory {
 		0, // tempimagecount
 		0, // temptextcount
 		0, // tempbackgroundcount
+		0, // tempstructcount
+		0, // templistcount
 
 		0, // constantfloatcount
 		0, // constantcharcount
@@ -231,8 +263,27 @@ func NewVirtualMemory() *VirtualMemory {
 
 // GetNextGlobal Receives the type and determines the next global variable available in the scope to assign it
 func (vm *VirtualMemory) GetNextGlobal(t *types.Type) (Address, error) {
+	// List
+	if t.IsDynamic() {
+		if vm.globallistcount+1 >= segmentsize {
+			return Address(-1), errutil.Newf("Error: global variables for lists exceeded.")
+		}
+		result := vm.globallistcount + ListOffset + Globalstart
+		vm.globallistcount++
+		return Address(result), nil
+	}
 	nt, amount := normalizeType(t)
 	objAmount := amount * objectSize
+	// Struct
+	if nt.IsStruct() {
+		cells := amount * nt.Struct().Size()
+		if vm.globalstructcount+cells >= segmentsize {
+			return Address(-1), errutil.Newf("Error: global variables for structs exceeded.")
+		}
+		result := vm.globalstructcount + StructOffset + Globalstart
+		vm.globalstructcount += cells
+		return Address(result), nil
+	}
 	switch nt.String() {
 	// Float
 	case "1":
@@ -321,8 +372,27 @@ func (vm *VirtualMemory) GetNextGlobal(t *types.Type) (Address, error) {
 
 // GetNextLocal Receives the type and determines the next local variable available in the scope to assign it
 func (vm *VirtualMemory) GetNextLocal(t *types.Type) (Address, error) {
+	// List
+	if t.IsDynamic() {
+		if vm.locallistcount+1 >= segmentsize {
+			return Address(-1), errutil.Newf("Error: local variables for lists exceeded.")
+		}
+		result := vm.locallistcount + ListOffset + Localstart
+		vm.locallistcount++
+		return Address(result), nil
+	}
 	nt, amount := normalizeType(t)
 	objAmount := amount * objectSize
+	// Struct
+	if nt.IsStruct() {
+		cells := amount * nt.Struct().Size()
+		if vm.localstructcount+cells >= segmentsize {
+			return Address(-1), errutil.Newf("Error: local variables for structs exceeded.")
+		}
+		result := vm.localstructcount + StructOffset + Localstart
+		vm.localstructcount += cells
+		return Address(result), nil
+	}
 	switch nt.String() {
 	// Float
 	case "1":
@@ -411,8 +481,27 @@ func (vm *VirtualMemory) GetNextLocal(t *types.Type) (Address, error) {
 
 // GetNextTemp Receives the type and determines the next temp variable available in the scope to assign it
 func (vm *VirtualMemory) GetNextTemp(t *types.Type) (Address, error) {
+	// List
+	if t.IsDynamic() {
+		if vm.templistcount+1 >= segmentsize {
+			return Address(-1), errutil.Newf("Error: temp variables for lists exceeded.")
+		}
+		result := vm.templistcount + ListOffset + Tempstart
+		vm.templistcount++
+		return Address(result), nil
+	}
 	nt, amount := normalizeType(t)
 	objAmount := amount * objectSize
+	// Struct
+	if nt.IsStruct() {
+		cells := amount * nt.Struct().Size()
+		if vm.tempstructcount+cells >= segmentsize {
+			return Address(-1), errutil.Newf("Error: temp variables for structs exceeded.")
+		}
+		result := vm.tempstructcount + StructOffset + Tempstart
+		vm.tempstructcount += cells
+		return Address(result), nil
+	}
 	switch nt.String() {
 	// Float
 	case "1":
@@ -591,17 +680,10 @@ func (vm *VirtualMemory) getNextConstant(t *types.Type) (Address, error) {
 }
 
 // normalizeType converts Type list into normal type and returns the amount of elements in the
-// list, together with the new type. If the type is already a non-list type, the function
-// returns the same type with a 1 amount
+// list, counting every dimension, together with the new type. If the type is already a non-list
+// type, the function returns the same type with a 1 amount
 func normalizeType(t *types.Type) (*types.Type, int) {
-	nt := t.Copy()
-	amount := 1
-	if nt.List() > 0 {
-		nt.DecreaseList()
-		amount = nt.Size()
-	}
-
-	return nt, amount
+	return t.Elem(), t.Elements()
 }
 
 func (vm *VirtualMemory) ResetLocal() {
@@ -615,6 +697,8 @@ func (vm *VirtualMemory) ResetLocal() {
 	vm.localimagecount = 0
 	vm.localtextcount = 0
 	vm.localbackgroundcount = 0
+	vm.localstructcount = 0
+	vm.locallistcount = 0
 }
 
 func (vm *VirtualMemory) ResetTemp() {
@@ -628,6 +712,8 @@ func (vm *VirtualMemory) ResetTemp() {
 	vm.tempimagecount = 0
 	vm.temptextcount = 0
 	vm.tempbackgroundcount = 0
+	vm.tempstructcount = 0
+	vm.templistcount = 0
 }
 
 func (vm *VirtualMemory) ConstantExists(c string) bool {
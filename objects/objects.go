@@ -401,4 +401,58 @@ func (o *Background) SetMessage(x string) {
 
 func (o *Background) String() string {
 	return fmt.Sprintf("Background = x: %f, y: %f, width: %f, height: %f, image: %s", o.x, o.y, o.width, o.height, o.image)
-}
\ No newline at end of file
+}
+
+// Struct is the value of a struct declared in the program, with the values of its fields in the order
+// of the declaration
+type Struct struct {
+	fields []interface{}
+}
+
+func (o Struct) Fields() []interface{} {
+	return o.fields
+}
+
+func NewStruct(fields []interface{}) Struct {
+	return Struct{fields}
+}
+
+// List is the value of a list<T>, which lives in the heap and is shared by every variable that
+// references it. Its elements are copies of the values appended to it
+type List struct {
+	elems []interface{}
+}
+
+func (o *List) Len() int {
+	return len(o.elems)
+}
+
+// Elems are the elements of the list, in the order they were appended
+func (o *List) Elems() []interface{} {
+	return o.elems
+}
+
+func (o *List) Get(i int) interface{} {
+	return o.elems[i]
+}
+
+func (o *List) Set(i int, v interface{}) {
+	o.elems[i] = v
+}
+
+func (o *List) Append(v interface{}) {
+	o.elems = append(o.elems, v)
+}
+
+// Remove takes out the element at i, the ones after it are moved one position back
+func (o *List) Remove(i int) {
+	o.elems = append(o.elems[:i], o.elems[i+1:]...)
+}
+
+func (o *List) Clear() {
+	o.elems = o.elems[:0]
+}
+
+func NewList() *List {
+	return &List{make([]interface{}, 0)}
+}
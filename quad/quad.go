@@ -44,6 +44,30 @@ const (
 	Clear
 	Update
 
+	LtEqual
+	GtEqual
+	NotEqual
+	Neg
+	Mod
+	This
+	ListAppend
+	ListLen
+	ListRemove
+	ListClear
+	ListGet
+	ListSet
+	StrIndex
+	StrLen
+	Substr
+	IndexOf
+	ToString
+	ParseInt
+	ParseFloat
+	IntToFloat
+	FloatToInt
+	IntToChar
+	CharToInt
+
 	Invalid
 )
 
@@ -111,6 +135,52 @@ func (o Operation) String() string {
 		return "Clear"
 	case Update:
 		return "Update"
+	case LtEqual:
+		return "<="
+	case GtEqual:
+		return ">="
+	case NotEqual:
+		return "NotEqual"
+	case Neg:
+		return "Neg"
+	case Mod:
+		return "%"
+	case This:
+		return "This"
+	case ListAppend:
+		return "ListAppend"
+	case ListLen:
+		return "ListLen"
+	case ListRemove:
+		return "ListRemove"
+	case ListClear:
+		return "ListClear"
+	case ListGet:
+		return "ListGet"
+	case ListSet:
+		return "ListSet"
+	case StrIndex:
+		return "StrIndex"
+	case StrLen:
+		return "StrLen"
+	case Substr:
+		return "Substr"
+	case IndexOf:
+		return "IndexOf"
+	case ToString:
+		return "ToString"
+	case ParseInt:
+		return "ParseInt"
+	case ParseFloat:
+		return "ParseFloat"
+	case IntToFloat:
+		return "IntToFloat"
+	case FloatToInt:
+		return "FloatToInt"
+	case IntToChar:
+		return "IntToChar"
+	case CharToInt:
+		return "CharToInt"
 	}
 
 	return ""
@@ -180,6 +250,52 @@ func GetOperation(s string) Operation {
 		return Clear
 	case "Update":
 		return Update
+	case "<=":
+		return LtEqual
+	case ">=":
+		return GtEqual
+	case "NotEqual":
+		return NotEqual
+	case "Neg":
+		return Neg
+	case "%":
+		return Mod
+	case "This":
+		return This
+	case "ListAppend":
+		return ListAppend
+	case "ListLen":
+		return ListLen
+	case "ListRemove":
+		return ListRemove
+	case "ListClear":
+		return ListClear
+	case "ListGet":
+		return ListGet
+	case "ListSet":
+		return ListSet
+	case "StrIndex":
+		return StrIndex
+	case "StrLen":
+		return StrLen
+	case "Substr":
+		return Substr
+	case "IndexOf":
+		return IndexOf
+	case "ToString":
+		return ToString
+	case "ParseInt":
+		return ParseInt
+	case "ParseFloat":
+		return ParseFloat
+	case "IntToFloat":
+		return IntToFloat
+	case "FloatToInt":
+		return FloatToInt
+	case "IntToChar":
+		return IntToChar
+	case "CharToInt":
+		return CharToInt
 	}
 
 	return Invalid
@@ -227,6 +343,16 @@ func StringToOperation(s string) Operation {
 		return Assign
 	case "Render":
 		return Render
+	case "CheckBound":
+		return CheckBound
+	case "AddAddr":
+		return AddAddr
+	case "AssignIndex":
+		return AssignIndex
+	case "AssignIndexInv":
+		return AssignIndexInv
+	case "Init":
+		return Init
 	case "KeyPressed":
 		return KeyPressed
 	case "CheckCollision":
@@ -239,6 +365,52 @@ func StringToOperation(s string) Operation {
 		return Clear
 	case "Update":
 		return Update
+	case "<=":
+		return LtEqual
+	case ">=":
+		return GtEqual
+	case "NotEqual":
+		return NotEqual
+	case "Neg":
+		return Neg
+	case "%":
+		return Mod
+	case "This":
+		return This
+	case "ListAppend":
+		return ListAppend
+	case "ListLen":
+		return ListLen
+	case "ListRemove":
+		return ListRemove
+	case "ListClear":
+		return ListClear
+	case "ListGet":
+		return ListGet
+	case "ListSet":
+		return ListSet
+	case "StrIndex":
+		return StrIndex
+	case "StrLen":
+		return StrLen
+	case "Substr":
+		return Substr
+	case "IndexOf":
+		return IndexOf
+	case "ToString":
+		return ToString
+	case "ParseInt":
+		return ParseInt
+	case "ParseFloat":
+		return ParseFloat
+	case "IntToFloat":
+		return IntToFloat
+	case "FloatToInt":
+		return FloatToInt
+	case "IntToChar":
+		return IntToChar
+	case "CharToInt":
+		return CharToInt
 	}
 
 	return Invalid
@@ -2,9 +2,15 @@ package main
 
 import (
 	"os"
+	"flag"
 	"fmt"
+	"strings"
 
 	"github.com/sdkvictor/golang-compiler/ast"
+	"github.com/sdkvictor/golang-compiler/bytecode"
+	"github.com/sdkvictor/golang-compiler/diagnostics"
+	"github.com/sdkvictor/golang-compiler/engine"
+	"github.com/sdkvictor/golang-compiler/engine/window"
 	"github.com/sdkvictor/golang-compiler/gocc/lexer"
 	"github.com/sdkvictor/golang-compiler/gocc/parser"
 	"github.com/sdkvictor/golang-compiler/semantics"
@@ -15,8 +21,23 @@ import (
 	//"github.com/davecgh/go-spew/spew"
 )
 
+var (
+	forceWindow   = flag.Bool("window", false, "always open a window, even if the program does not draw")
+	forceHeadless = flag.Bool("headless", false, "never open a window, graphics builtins do nothing")
+	maxFrames     = flag.Int("frames", 0, "stop a program without a window after this amount of frames, 0 runs forever")
+	pngDir        = flag.String("png", "", "draw every frame offscreen and write it as a PNG file to this directory")
+	inputFile     = flag.String("input", "", "read the keys from a script file instead of the keyboard")
+	recordFile    = flag.String("record", "", "write every change of the keys to a session file that can be replayed with -input")
+	output        = flag.String("o", "", "bytecode file written by build, by default the source file with the .obj extension")
+)
+
 func usage() {
-	fmt.Printf("Usage: run <vm source file>\n")
+	fmt.Printf("Usage: run [flags] <vm source file>\n")
+	fmt.Printf("       run build [-o object file] <vm source file>\n")
+	fmt.Printf("       run exec [flags] <object file>\n")
+	fmt.Printf("       run disasm <vm source file or object file>\n")
+	fmt.Printf("       run debug [-input script] <vm source file or object file>\n")
+	flag.PrintDefaults()
 }
 
 func readFile(path string) ([]byte, error) {
@@ -43,63 +64,71 @@ func readFile(path string) ([]byte, error) {
 	return buffer, nil
 }
 
-func compile(file string) (*ic.Generator, map[string]int, error) {
+func compile(file string) (*bytecode.Program, error) {
 	p := parser.NewParser()
 	input, err := readFile(file)
 
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	s := lexer.NewLexer(input)
 	pro, err := p.Parse(s)
 
 	if err != nil {
-		return nil, nil, err
+		err = diagnostics.FromParseError(err)
+		if diags, ok := err.(diagnostics.List); ok {
+			diags.SetFile(file)
+		}
+		return nil, err
 	}
 
 	program, ok := pro.(*ast.Program)
 	if !ok {
-		return nil, nil, errutil.NewNoPos("Cannot cast program")
+		return nil, errutil.NewNoPos("Cannot cast program")
 	}
 
 	funcdir, globals, err := semantics.SemanticCheck(program)
 	if err != nil {
-		return nil, nil, err
+		if diags, ok := err.(diagnostics.List); ok {
+			diags.SetFile(file)
+		}
+		return nil, err
 	}
 
 	gen, vm, err := ic.GenerateIntermediateCode(program, funcdir, globals)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	return gen, vm.GetConstantMap(), nil
+	return bytecode.NewProgram(file, gen.Quadruples(), gen.Positions(), vm.GetConstantMap(), funcdir, globals)
 }
 
-func run() {
-	if len(os.Args) < 2 {
-		usage()
-		return
-	}
-
-	file := os.Args[1]
-
-	gen, consmap, err := compile(file)
-	if err != nil {
+// reportCompilation prints the error of a compilation, the diagnostics of the program are shown
+// with the lines of the source where they are found
+func reportCompilation(file string, err error) {
+	diags, ok := err.(diagnostics.List)
+	if !ok {
 		fmt.Printf("Compilation %v\n", err)
 		return
 	}
 
+	source, _ := readFile(file)
+	fmt.Print(diags.Format(source))
+}
+
+func run(prog *bytecode.Program, e engine.Engine) {
 	fmt.Printf("\n\n\n\n\n\n\n\n\n\n\n\n\n\n\n\n\n\n\n\n")
 
-	//spew.Dump(consmap)
+	//spew.Dump(prog.Constants)
 
-	mach := vm.NewVirtualMachine(gen.Quadruples(), consmap)
-	err = mach.LoadConstants(consmap)
+	mach := vm.NewVirtualMachine(prog.Quads, e)
+	err := mach.LoadConstantPool(prog.Constants)
 	if err != nil {
 		fmt.Printf("Setup %v\n", err)
 		return
 	}
+	mach.LoadDebugInfo(prog)
 	
 	
 	//fmt.Printf("%s\n", mach)
@@ -111,6 +140,203 @@ func run() {
 	}
 }
 
+// play runs the program replacing the keys of the engine by the ones of the script if there is one,
+// and recording them if a session file was given
+func play(prog *bytecode.Program, e engine.Engine, script *engine.Script) {
+	if script != nil {
+		e = engine.NewPlayback(e, script)
+	}
+
+	if *recordFile != "" {
+		rec, err := engine.NewRecorder(e, *recordFile)
+		if err != nil {
+			fmt.Printf("Record %v\n", err)
+			return
+		}
+
+		defer func() {
+			if err := rec.Close(); err != nil {
+				fmt.Printf("Record %v\n", err)
+			}
+		}()
+		e = rec
+	}
+
+	run(prog, e)
+}
+
+// build compiles the source file and writes the program to an object file that can be run with exec
+func build(file string) {
+	prog, err := compile(file)
+	if err != nil {
+		reportCompilation(file, err)
+		return
+	}
+
+	path := strings.TrimSuffix(file, ".vm") + ".obj"
+	if *output != "" {
+		path = *output
+	}
+
+	if err := bytecode.WriteFile(path, prog); err != nil {
+		fmt.Printf("Build %v\n", err)
+		return
+	}
+
+	fmt.Printf("Program written to %s\n", path)
+}
+
+// disasm prints the quads of a source or bytecode file with symbolic names
+func disasm(file string) {
+	var prog *bytecode.Program
+	var err error
+
+	if strings.HasSuffix(file, ".vm") {
+		prog, err = compile(file)
+		if err != nil {
+			reportCompilation(file, err)
+			return
+		}
+	} else {
+		prog, err = vm.LoadProgram(file)
+	}
+
+	if err != nil {
+		fmt.Printf("Disassembly %v\n", err)
+		return
+	}
+
+	fmt.Print(bytecode.Disassemble(prog))
+}
+
+// debug runs a source or bytecode file in the step debugger, which reads its commands from the
+// standard input. Graphics builtins do nothing while debugging
+func debug(file string) {
+	var prog *bytecode.Program
+	var source []byte
+	var err error
+
+	if strings.HasSuffix(file, ".vm") {
+		source, err = readFile(file)
+		if err == nil {
+			prog, err = compile(file)
+			if err != nil {
+				reportCompilation(file, err)
+				return
+			}
+		}
+	} else {
+		prog, err = vm.LoadProgram(file)
+	}
+
+	if err != nil {
+		fmt.Printf("Debug %v\n", err)
+		return
+	}
+
+	var e engine.Engine = engine.NewHeadless(*maxFrames)
+	if *inputFile != "" {
+		script, err := engine.LoadScript(*inputFile)
+		if err != nil {
+			fmt.Printf("Input %v\n", err)
+			return
+		}
+		e = engine.NewPlayback(e, script)
+	}
+
+	d, err := vm.NewDebugger(prog, source, e, os.Stdin, os.Stdout)
+	if err != nil {
+		fmt.Printf("Setup %v\n", err)
+		return
+	}
+
+	if err := d.Run(); err != nil {
+		fmt.Printf("Runtime %v\n", err)
+	}
+}
+
 func main() {
-	pixelgl.Run(run)
-}
\ No newline at end of file
+	flag.Usage = usage
+
+	// The command is optional, without one the source file is compiled and run
+	command := ""
+	args := os.Args[1:]
+	if len(args) > 0 && (args[0] == "build" || args[0] == "exec" || args[0] == "disasm" || args[0] == "debug") {
+		command = args[0]
+		args = args[1:]
+	}
+	flag.CommandLine.Parse(args)
+
+	if flag.NArg() < 1 {
+		usage()
+		return
+	}
+
+	file := flag.Arg(0)
+
+	if command == "build" {
+		build(file)
+		return
+	}
+
+	var prog *bytecode.Program
+	var err error
+
+	if command == "disasm" {
+		disasm(file)
+		return
+	}
+
+	if command == "debug" {
+		debug(file)
+		return
+	}
+
+	if command == "exec" {
+		prog, err = vm.LoadProgram(file)
+		if err != nil {
+			fmt.Printf("Load %v\n", err)
+			return
+		}
+	} else {
+		prog, err = compile(file)
+		if err != nil {
+			reportCompilation(file, err)
+			return
+		}
+	}
+
+	var script *engine.Script
+	if *inputFile != "" {
+		script, err = engine.LoadScript(*inputFile)
+		if err != nil {
+			fmt.Printf("Input %v\n", err)
+			return
+		}
+	}
+
+	if *pngDir != "" {
+		if err := os.MkdirAll(*pngDir, 0755); err != nil {
+			fmt.Printf("Setup %v\n", err)
+			return
+		}
+
+		e := engine.NewOffscreen(730, 500, *maxFrames, *pngDir)
+		play(prog, e, script)
+		if err := e.Err(); err != nil {
+			fmt.Printf("Render %v\n", err)
+		}
+		return
+	}
+
+	// A window is only opened if the program uses graphics builtins or if it is explicitly asked for
+	if *forceHeadless || (!*forceWindow && !vm.UsesGraphics(prog.Quads)) {
+		play(prog, engine.NewHeadless(*maxFrames), script)
+		return
+	}
+
+	// pixelgl needs to own the main thread, so the window is created inside of Run
+	pixelgl.Run(func() {
+		play(prog, window.NewEngine("Ping Pong", 730, 500), script)
+	})
+}
@@ -0,0 +1,49 @@
+package semantics
+
+import (
+	"github.com/sdkvictor/golang-compiler/ast"
+	"github.com/sdkvictor/golang-compiler/diagnostics"
+	"github.com/sdkvictor/golang-compiler/directories"
+	"github.com/sdkvictor/golang-compiler/gocc/token"
+	"github.com/sdkvictor/golang-compiler/types"
+)
+
+// castSources are the types each cast accepts, casting a value to its own type does nothing
+var castSources = map[types.BasicType][]types.BasicType{
+	types.Int:   {types.Int, types.Float, types.Char},
+	types.Float: {types.Float, types.Int},
+	types.Char:  {types.Char, types.Int},
+}
+
+// IsPromotion tells if a value of type from is promoted to type to when it is assigned, passed or
+// returned. Only an int is promoted to float, any other conversion needs a cast
+func IsPromotion(from, to *types.Type) bool {
+	return from.Equal(types.NewDataType(types.Int, 0, 0)) && to.Equal(types.NewDataType(types.Float, 0, 0))
+}
+
+//typeCheckLossyConversion reports storing a float in an int, which needs an explicit cast since the
+// decimals are lost. where tells the reader where the conversion happens
+func typeCheckLossyConversion(from, to *types.Type, tok *token.Token, where string) error {
+	if from.Equal(types.NewDataType(types.Float, 0, 0)) && to.Equal(types.NewDataType(types.Int, 0, 0)) {
+		return diagnostics.Errorf(ErrLossyConversion, tok, "Cannot convert float to int implicitly in %s, use int() to truncate it", where)
+	}
+
+	return nil
+}
+
+func typeCheckCast(c *ast.Cast, ctx *SemanticContext, fe *directories.FuncEntry) (*types.Type, error) {
+	t, err := typeCheckExpression(c.Expression(), ctx, fe)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.List() == 0 && !t.IsDynamic() && !t.IsObject() && !t.IsStruct() {
+		for _, b := range castSources[c.Type().Basic()] {
+			if t.Basic() == b {
+				return c.Type(), nil
+			}
+		}
+	}
+
+	return nil, diagnostics.Errorf(ErrInvalidOperation, c.Token(), "Cannot cast %s to %s", t.Name(), c.Type().Name())
+}
@@ -0,0 +1,420 @@
+package semantics
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sdkvictor/golang-compiler/ast"
+	"github.com/sdkvictor/golang-compiler/diagnostics"
+	"github.com/sdkvictor/golang-compiler/directories"
+	"github.com/sdkvictor/golang-compiler/gocc/token"
+	"github.com/sdkvictor/golang-compiler/types"
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// folded is the value of an expression computed at compile time. v is a float64, int, rune, bool or string
+// depending on the basic type of t
+type folded struct {
+	t *types.Type
+	v interface{}
+}
+
+// literal is the text of the value used as the key of the constant segment. A float always has a decimal
+// point so it never shares an address with an int, and a char keeps its quotes
+func (f *folded) literal() string {
+	switch v := f.v.(type) {
+	case float64:
+		s := strconv.FormatFloat(v, 'f', -1, 64)
+		if !strings.Contains(s, ".") {
+			s += ".0"
+		}
+		return s
+	case int:
+		return strconv.Itoa(v)
+	case rune:
+		return "'" + string(v) + "'"
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return v
+	}
+	return ""
+}
+
+// parseFolded reads the literal of a constant of type t
+func parseFolded(lit string, t *types.Type) (*folded, error) {
+	switch t.Basic() {
+	case types.Float:
+		f, err := strconv.ParseFloat(lit, 64)
+		return &folded{t, f}, err
+	case types.Int:
+		i, err := strconv.Atoi(lit)
+		return &folded{t, i}, err
+	case types.Char:
+		r := []rune(lit)
+		if len(r) != 3 {
+			return nil, errutil.NewNoPosf("Invalid char literal %s", lit)
+		}
+		return &folded{t, r[1]}, nil
+	case types.Bool:
+		b, err := strconv.ParseBool(lit)
+		return &folded{t, b}, err
+	case types.String:
+		return &folded{t, lit}, nil
+	}
+	return nil, errutil.NewNoPosf("Cannot fold a value of type %s", t.Name())
+}
+
+//typeCheckConstantType verifies the constant is of a basic type, arrays, lists and objects cannot be constants
+func typeCheckConstantType(ve *directories.VarEntry) error {
+	t := ve.Type()
+	if t.List() > 0 || t.IsDynamic() || t.IsObject() || t.IsStruct() {
+		return diagnostics.Errorf(ErrInvalidConstant, ve.Token(), "Constant %s cannot be of type %s", ve.Id(), t.Name())
+	}
+
+	return nil
+}
+
+//foldConstantDec computes the value of a constant declaration, which can only use literals, other constants,
+// operators and casts
+func foldConstantDec(ve *directories.VarEntry, exp *ast.Expression, ctx *SemanticContext, fe *directories.FuncEntry) error {
+	t := ve.Type()
+	f, err := foldExpression(exp, ctx, fe)
+	if err != nil {
+		return err
+	}
+
+	if IsPromotion(f.t, t) {
+		f = &folded{t, float64(f.v.(int))}
+	}
+
+	ve.SetValue(f.literal())
+	return nil
+}
+
+func foldExpression(expression *ast.Expression, ctx *SemanticContext, fe *directories.FuncEntry) (*folded, error) {
+	prev, err := foldAndExp(expression.AndExps()[0], ctx, fe)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, op := range expression.Operations() {
+		next := expression.AndExps()[i+1]
+		nextVal, err := foldAndExp(next, ctx, fe)
+		if err != nil {
+			return nil, err
+		}
+
+		prev, err = foldOperation(op, prev, nextVal, next.Token(), ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return prev, nil
+}
+
+func foldAndExp(and *ast.AndExp, ctx *SemanticContext, fe *directories.FuncEntry) (*folded, error) {
+	prev, err := foldEqualityExp(and.EqualityExps()[0], ctx, fe)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, op := range and.Operations() {
+		next := and.EqualityExps()[i+1]
+		nextVal, err := foldEqualityExp(next, ctx, fe)
+		if err != nil {
+			return nil, err
+		}
+
+		prev, err = foldOperation(op, prev, nextVal, next.Token(), ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return prev, nil
+}
+
+func foldEqualityExp(eq *ast.EqualityExp, ctx *SemanticContext, fe *directories.FuncEntry) (*folded, error) {
+	prev, err := foldRelationalExp(eq.RelationalExps()[0], ctx, fe)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, op := range eq.Operations() {
+		next := eq.RelationalExps()[i+1]
+		nextVal, err := foldRelationalExp(next, ctx, fe)
+		if err != nil {
+			return nil, err
+		}
+
+		prev, err = foldOperation(op, prev, nextVal, next.Token(), ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return prev, nil
+}
+
+func foldRelationalExp(rel *ast.RelationalExp, ctx *SemanticContext, fe *directories.FuncEntry) (*folded, error) {
+	prev, err := foldExp(rel.Exps()[0], ctx, fe)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, op := range rel.Operations() {
+		next := rel.Exps()[i+1]
+		nextVal, err := foldExp(next, ctx, fe)
+		if err != nil {
+			return nil, err
+		}
+
+		prev, err = foldOperation(op, prev, nextVal, next.Token(), ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return prev, nil
+}
+
+func foldExp(exp *ast.Exp, ctx *SemanticContext, fe *directories.FuncEntry) (*folded, error) {
+	prev, err := foldTerm(exp.Terms()[0], ctx, fe)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, op := range exp.Operations() {
+		next := exp.Terms()[i+1]
+		nextVal, err := foldTerm(next, ctx, fe)
+		if err != nil {
+			return nil, err
+		}
+
+		prev, err = foldOperation(op, prev, nextVal, next.Token(), ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return prev, nil
+}
+
+func foldTerm(term *ast.Term, ctx *SemanticContext, fe *directories.FuncEntry) (*folded, error) {
+	prev, err := foldFactor(term.Factors()[0], ctx, fe)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, op := range term.Operations() {
+		next := term.Factors()[i+1]
+		nextVal, err := foldFactor(next, ctx, fe)
+		if err != nil {
+			return nil, err
+		}
+
+		prev, err = foldOperation(op, prev, nextVal, next.Token(), ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return prev, nil
+}
+
+func foldFactor(factor *ast.Factor, ctx *SemanticContext, fe *directories.FuncEntry) (*folded, error) {
+	if factor.Expression() != nil {
+		return foldExpression(factor.Expression(), ctx, fe)
+	}
+
+	if factor.Constant() != nil {
+		return foldConstant(factor.Constant(), ctx, fe)
+	}
+
+	if factor.Unary() != nil {
+		return foldUnary(factor.Unary(), ctx, fe)
+	}
+
+	return nil, errutil.Newf("Factor expression or constant not set %+v", factor.Token())
+}
+
+func foldUnary(unary *ast.Unary, ctx *SemanticContext, fe *directories.FuncEntry) (*folded, error) {
+	f, err := foldFactor(unary.Factor(), ctx, fe)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := f.v.(type) {
+	case float64:
+		return &folded{f.t, -v}, nil
+	case int:
+		return &folded{f.t, -v}, nil
+	case bool:
+		return &folded{f.t, !v}, nil
+	}
+
+	return nil, diagnostics.Errorf(ErrInvalidOperation, unary.Token(), "Invalid operation %s%s", unary.Operation(), f.t.Name())
+}
+
+//foldConstant reads a literal or a constant declared before, any other value is only known when the program runs
+func foldConstant(constant ast.Constant, ctx *SemanticContext, fe *directories.FuncEntry) (*folded, error) {
+	if cv, ok := constant.(*ast.ConstantValue); ok {
+		return parseFolded(cv.Value(), cv.Type())
+	} else if c, ok := constant.(*ast.Cast); ok {
+		return foldCast(c, ctx, fe)
+	} else if att, ok := constant.(*ast.Attribute); ok && att.VarId() == "" && len(att.Indexes()) == 0 {
+		ve := fe.VarDir().Get(att.ObjId())
+		if ve == nil {
+			ve = ctx.Globals().Get(att.ObjId())
+		}
+		if ve != nil && ve.IsConstant() {
+			return parseFolded(ve.Value(), ve.Type())
+		}
+		return nil, diagnostics.Errorf(ErrInvalidConstant, att.Token(), "Value of a constant must be known at compile time, %s is not a constant", att.ObjId())
+	}
+
+	return nil, diagnostics.Errorf(ErrInvalidConstant, constant.Token(), "Value of a constant must be known at compile time")
+}
+
+// foldCast converts the value like the cast quads do when the program runs
+func foldCast(c *ast.Cast, ctx *SemanticContext, fe *directories.FuncEntry) (*folded, error) {
+	f, err := foldExpression(c.Expression(), ctx, fe)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.t.Equal(c.Type()) {
+		return f, nil
+	}
+
+	switch v := f.v.(type) {
+	case float64:
+		if math.IsNaN(v) || v >= math.MaxInt64 || v < math.MinInt64 {
+			return nil, diagnostics.Errorf(ErrInvalidConstant, c.Token(), "Cannot convert %v to int", v)
+		}
+		return &folded{c.Type(), int(v)}, nil
+	case rune:
+		return &folded{c.Type(), int(v)}, nil
+	case int:
+		switch c.Type().Basic() {
+		case types.Float:
+			return &folded{c.Type(), float64(v)}, nil
+		case types.Char:
+			if v < 0 || v > utf8.MaxRune || !utf8.ValidRune(rune(v)) {
+				return nil, diagnostics.Errorf(ErrInvalidConstant, c.Token(), "Cannot convert %d to char, it is not a valid character code", v)
+			}
+			return &folded{c.Type(), rune(v)}, nil
+		}
+	}
+
+	return nil, diagnostics.Errorf(ErrInvalidOperation, c.Token(), "Cannot cast %s to %s", f.t.Name(), c.Type().Name())
+}
+
+//foldOperation computes a binary operation, an int operated with a float is promoted to float first
+func foldOperation(op string, prev, next *folded, nextTok *token.Token, ctx *SemanticContext) (*folded, error) {
+	t, err := typeCheckOperation(op, prev.t, next.t, nextTok, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	l, r := prev.v, next.v
+	if IsPromotion(prev.t, next.t) {
+		l = float64(l.(int))
+	} else if IsPromotion(next.t, prev.t) {
+		r = float64(r.(int))
+	}
+
+	switch op {
+	case "&&":
+		return &folded{t, l.(bool) && r.(bool)}, nil
+	case "||":
+		return &folded{t, l.(bool) || r.(bool)}, nil
+	case "==":
+		return &folded{t, l == r}, nil
+	case "!=":
+		return &folded{t, l != r}, nil
+	case "<":
+		return &folded{t, compareFolded(l, r) < 0}, nil
+	case ">":
+		return &folded{t, compareFolded(l, r) > 0}, nil
+	case "<=":
+		return &folded{t, compareFolded(l, r) <= 0}, nil
+	case ">=":
+		return &folded{t, compareFolded(l, r) >= 0}, nil
+	}
+
+	switch lv := l.(type) {
+	case string:
+		return &folded{t, lv + r.(string)}, nil
+	case int:
+		rv := r.(int)
+		if rv == 0 && (op == "/" || op == "%") {
+			return nil, diagnostics.Errorf(ErrInvalidConstant, nextTok, "Division by zero in constant")
+		}
+		switch op {
+		case "+":
+			return &folded{t, lv + rv}, nil
+		case "-":
+			return &folded{t, lv - rv}, nil
+		case "*":
+			return &folded{t, lv * rv}, nil
+		case "/":
+			return &folded{t, lv / rv}, nil
+		case "%":
+			return &folded{t, lv % rv}, nil
+		}
+	case float64:
+		rv := r.(float64)
+		if rv == 0 && (op == "/" || op == "%") {
+			return nil, diagnostics.Errorf(ErrInvalidConstant, nextTok, "Division by zero in constant")
+		}
+		var res float64
+		switch op {
+		case "+":
+			res = lv + rv
+		case "-":
+			res = lv - rv
+		case "*":
+			res = lv * rv
+		case "/":
+			res = lv / rv
+		case "%":
+			res = math.Mod(lv, rv)
+		}
+		if math.IsInf(res, 0) {
+			return nil, diagnostics.Errorf(ErrInvalidConstant, nextTok, "Constant overflows float")
+		}
+		return &folded{t, res}, nil
+	}
+
+	return nil, diagnostics.Errorf(ErrInvalidOperation, nextTok, "Invalid operation %s %s %s", prev.t.Name(), op, next.t.Name())
+}
+
+// compareFolded orders two values of the same type, chars and strings by their characters
+func compareFolded(l, r interface{}) int {
+	switch lv := l.(type) {
+	case int:
+		rv := r.(int)
+		if lv < rv {
+			return -1
+		} else if lv > rv {
+			return 1
+		}
+	case float64:
+		rv := r.(float64)
+		if lv < rv {
+			return -1
+		} else if lv > rv {
+			return 1
+		}
+	case rune:
+		return int(lv - r.(rune))
+	case string:
+		return strings.Compare(lv, r.(string))
+	}
+	return 0
+}
@@ -2,22 +2,23 @@ package semantics
 
 import (
 	"github.com/sdkvictor/golang-compiler/ast"
+	"github.com/sdkvictor/golang-compiler/diagnostics"
 	"github.com/sdkvictor/golang-compiler/directories"
 	"github.com/sdkvictor/golang-compiler/types"
-	"github.com/mewkiz/pkg/errutil"
 )
 
-//buildFuncDirProgram receives the program and the function directory to start building the funcdir
+//buildFuncDirProgram receives the program and the function directory to start building the funcdir,
+// it returns the errors of every function
 func buildFuncDirProgram(program *ast.Program, funcdir *directories.FuncDirectory) error {
+	var diags diagnostics.List
+
 	for _, f := range program.Functions() {
 		if err := buildFuncDirFunction(f, funcdir); err != nil { // CASI OK
-			return err
+			diags.Add(err)
 		}
 	}
 
-
-
-	return nil
+	return diags.Err()
 }
 
 //buildFuncDirFunction creates a new FuncEntry for the function and adds it to the directory
@@ -28,11 +29,11 @@ func buildFuncDirFunction(function *ast.Function, funcdir *directories.FuncDirec
 	params := make([]*types.Type, 0)
 
 	if funcdir.Exists(id) {
-		return errutil.NewNoPosf("%+v: Redeclaration of function %s", function.Token(), id)
+		return diagnostics.Errorf(ErrRedeclaredFunction, function.Token(), "Redeclaration of function %s", id)
 	}
 
 	if IdIsReserved(id) {
-		return errutil.NewNoPosf("%+v: Cannot declare a function with reserved keyword %s", function.Token(), id)
+		return diagnostics.Errorf(ErrReservedName, function.Token(), "Cannot declare a function with reserved keyword %s", id)
 	}
 
 	FixParams(function.Params())
@@ -46,13 +47,13 @@ func buildFuncDirFunction(function *ast.Function, funcdir *directories.FuncDirec
 
 	// ?? y la variable reservedFunctions de funcutil?
 	if kw, ok := checkVarDirReserved(vardir); !ok {
-		return errutil.NewNoPosf("%+v: Cannot declare variable with reserved keyword %s", function.Token(), kw)
+		return diagnostics.Errorf(ErrReservedName, vardir.Get(kw).Token(), "Cannot declare variable with reserved keyword %s", kw)
 	}
 
 	fe := directories.NewFuncEntry(id, t, params, vardir)
 
 	if ok := funcdir.Add(fe); !ok {
-		return errutil.NewNoPosf("%+v: Invalid Function. This Function already exists.", function.Token())
+		return diagnostics.Errorf(ErrRedeclaredFunction, function.Token(), "Invalid Function. This Function already exists")
 	}
 
 	return nil
@@ -61,7 +62,7 @@ func buildFuncDirFunction(function *ast.Function, funcdir *directories.FuncDirec
 //buildVarDirFunction
 func buildVarDirFunction(ve *directories.VarEntry, vardir *directories.VarDirectory) error {
 	if ok := vardir.Add(ve); !ok {
-		return errutil.NewNoPosf("%+v: Invalid parameter. This parameter has already been declared.", ve.Token())
+		return diagnostics.Errorf(ErrRedeclaredVariable, ve.Token(), "Invalid parameter %s. This parameter has already been declared", ve.Id())
 	}
 	return nil
 }
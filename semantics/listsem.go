@@ -0,0 +1,75 @@
+package semantics
+
+import (
+	"github.com/sdkvictor/golang-compiler/ast"
+	"github.com/sdkvictor/golang-compiler/diagnostics"
+	"github.com/sdkvictor/golang-compiler/directories"
+	"github.com/sdkvictor/golang-compiler/types"
+)
+
+// listFunctionsParamAmount are the builtins of the lists, their first argument is always the list
+var listFunctionsParamAmount = map[string]int{
+	"append": 2,
+	"len":    1,
+	"remove": 2,
+	"clear":  1,
+}
+
+// IsListFunction tells if the id is one of the builtins of the lists
+func IsListFunction(id string) bool {
+	_, ok := listFunctionsParamAmount[id]
+	return ok
+}
+
+// GetListFunctionReturnType is int for len, the rest of the builtins of the lists are void
+func GetListFunctionReturnType(id string) *types.Type {
+	if id == "len" {
+		return types.NewDataType(types.Int, 0, 0)
+	}
+	return types.NewDataType(types.Void, 0, 0)
+}
+
+//typeCheckListFunction checks the call of a builtin of the lists, append takes a value of the type of
+// the elements and remove takes the index of the element
+func typeCheckListFunction(fc *ast.FunctionCall, ctx *SemanticContext, fe *directories.FuncEntry) (*types.Type, error) {
+	if a := listFunctionsParamAmount[fc.Id()]; len(fc.Params()) != a {
+		return nil, diagnostics.Errorf(ErrArgumentCount, fc.Token(), "Reserved function %s requires %d args, %d given", fc.Id(), a, len(fc.Params()))
+	}
+
+	lt, err := typeCheckExpression(fc.Params()[0], ctx, fe)
+	if err != nil {
+		return nil, err
+	}
+
+	// len also counts the characters of a string
+	if fc.Id() == "len" && isString(lt) {
+		return GetListFunctionReturnType(fc.Id()), nil
+	}
+
+	if !lt.IsDynamic() && fc.Id() == "len" {
+		return nil, diagnostics.Errorf(ErrArgumentType, fc.Params()[0].Token(), "In function call len, expected a list or a string for position 1, got %s", lt.Name())
+	} else if !lt.IsDynamic() {
+		return nil, diagnostics.Errorf(ErrArgumentType, fc.Params()[0].Token(), "In function call %s, expected a list for position 1, got %s", fc.Id(), lt.Name())
+	}
+
+	switch fc.Id() {
+	case "append":
+		t, err := typeCheckExpression(fc.Params()[1], ctx, fe)
+		if err != nil {
+			return nil, err
+		}
+		if !t.Equal(lt.Elem()) {
+			return nil, diagnostics.Errorf(ErrArgumentType, fc.Params()[1].Token(), "Cannot append %s to %s", t.Name(), lt.Name())
+		}
+	case "remove":
+		t, err := typeCheckExpression(fc.Params()[1], ctx, fe)
+		if err != nil {
+			return nil, err
+		}
+		if !t.Equal(types.NewDataType(types.Int, 0, 0)) {
+			return nil, diagnostics.Errorf(ErrArgumentType, fc.Params()[1].Token(), "In function call %s, expected type int for position 2, got %s", fc.Id(), t.Name())
+		}
+	}
+
+	return GetListFunctionReturnType(fc.Id()), nil
+}
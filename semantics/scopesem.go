@@ -2,26 +2,58 @@ package semantics
 
 import (
 	"github.com/sdkvictor/golang-compiler/ast"
+	"github.com/sdkvictor/golang-compiler/diagnostics"
 	"github.com/sdkvictor/golang-compiler/directories"
+	"github.com/sdkvictor/golang-compiler/types"
 	"github.com/mewkiz/pkg/errutil"
 )
 
-//scopeCheckProgram starts the scope checking for the whole program
-func scopeCheckProgram(program *ast.Program, ctx *SemanticContext) error {
+//scopeCheckProgram starts the scope checking for the whole program, the errors of every function are
+// added to the diagnostics of the context
+func scopeCheckProgram(program *ast.Program, ctx *SemanticContext) {
+	scopeCheckGlobals(program, ctx)
+
 	for _, f := range program.Functions() {
 		if err := scopeCheckFunction(f, ctx); err != nil {
-			return err
+			ctx.diags.Add(err)
 		}
 	}
+}
 
-	return nil
+//scopeCheckGlobals checks the initial values of the globals, which only see the globals declared before them
+func scopeCheckGlobals(program *ast.Program, ctx *SemanticContext) {
+	globals := ctx.globals
+	declared := directories.NewVarDirectory()
+	scope := GlobalScope()
+
+	for _, decl := range program.Decls() {
+		if decl.Init() != nil {
+			ctx.globals = declared
+			if err := scopeCheckExpression(decl.Init().Expression(), ctx, scope); err != nil {
+				ctx.diags.Add(err)
+				ctx.invalid[decl] = true
+			}
+			ctx.globals = globals
+		}
+
+		for _, ve := range decl.Variables() {
+			declared.Add(ve)
+		}
+	}
+}
+
+//GlobalScope is the function entry used to check and generate the initial values of the globals, it has no
+// variables of its own so every id is looked up in the globals
+func GlobalScope() *directories.FuncEntry {
+	return directories.NewFuncEntry("", types.NewDataType(types.Void, 0, 0), make([]*types.Type, 0), directories.NewVarDirectory())
 }
 
 //scopeCheckFunction verifies the function is added to the func directory and the checks its statements
 func scopeCheckFunction(function *ast.Function, ctx *SemanticContext) error {
 	fe := ctx.FuncDir().Get(function.Key())
 	if fe == nil {
-		return errutil.NewNoPosf("%+v: Function entry %+v not found in FuncDirectory", function.Token(), fe)
+		// The function could not be added to the directory, which was already reported
+		return nil
 	}
 
 	fe.SetVarcounter(len(fe.VarDir().Table()))
@@ -33,11 +65,12 @@ func scopeCheckFunction(function *ast.Function, ctx *SemanticContext) error {
 	return nil
 }
 
-//scopeCheckStatements 
+//scopeCheckStatements checks every statement, the ones with errors are reported and skipped by the type check
 func scopeCheckStatements(statements []ast.Statement, fe *directories.FuncEntry, ctx *SemanticContext) error {
 	for _, statement := range statements {
 		if err := scopeCheckStatement(statement, fe, ctx); err != nil {
-			return err
+			ctx.diags.Add(err)
+			ctx.invalid[statement] = true
 		}
 	}
 
@@ -47,11 +80,19 @@ func scopeCheckStatements(statements []ast.Statement, fe *directories.FuncEntry,
 //scopeCheckStatement calls the corresponding function to check the statement depending on the type
 func scopeCheckStatement(statement ast.Statement, fe *directories.FuncEntry, ctx *SemanticContext) error {
 	if vars, ok := statement.(*ast.Vars); ok {
-		return createVarsInFuncEntry(vars, fe)
+		// The initial value is checked before the variable exists, so it cannot use itself
+		if vars.Init() != nil {
+			if err := scopeCheckExpression(vars.Init().Expression(), ctx, fe); err != nil {
+				return err
+			}
+		}
+		return createVarsInFuncEntry(vars, fe, ctx)
 	} else if assign, ok := statement.(*ast.Assign); ok {
 		return scopeCheckAssign(assign, ctx, fe)
 	} else if condition, ok := statement.(*ast.Condition); ok {
 		return scopeCheckCondition(condition, ctx, fe)
+	} else if sw, ok := statement.(*ast.Switch); ok {
+		return scopeCheckSwitch(sw, ctx, fe)
 	} else if write, ok := statement.(*ast.Write); ok {
 		return scopeCheckWrite(write, ctx, fe)
 	} else if ret, ok := statement.(*ast.Return); ok {
@@ -62,6 +103,18 @@ func scopeCheckStatement(statement ast.Statement, fe *directories.FuncEntry, ctx
 		return scopeCheckWhile(while, ctx, fe)
 	} else if fcall, ok := statement.(*ast.FunctionCall); ok {
 		return scopeCheckFCall(fcall, ctx, fe)
+	} else if mcall, ok := statement.(*ast.ClassFunctionCall); ok {
+		return scopeCheckMethodCall(mcall, ctx, fe)
+	} else if br, ok := statement.(*ast.Break); ok {
+		if ctx.breaks == 0 {
+			return diagnostics.Errorf(ErrOutsideLoop, br.Token(), "break statement outside of a loop or switch")
+		}
+		return nil
+	} else if cont, ok := statement.(*ast.Continue); ok {
+		if ctx.loops == 0 {
+			return diagnostics.Errorf(ErrOutsideLoop, cont.Token(), "continue statement outside of a loop")
+		}
+		return nil
 	}
 
 	return errutil.Newf("Statement cannot be casted to any valid form")
@@ -122,6 +175,9 @@ func scopeCheckFor(forstr *ast.For, ctx *SemanticContext, fe *directories.FuncEn
 	}
 
 	//Check Block Statements
+	ctx.loops++
+	ctx.breaks++
+	defer func() { ctx.loops--; ctx.breaks-- }()
 	if err := scopeCheckStatements(forstr.Block(), fe, ctx) ; err != nil {
 		return err
 	}
@@ -135,6 +191,9 @@ func scopeCheckWhile(while *ast.While, ctx *SemanticContext, fe *directories.Fun
 		return err
 	}
 	//Check statements
+	ctx.loops++
+	ctx.breaks++
+	defer func() { ctx.loops--; ctx.breaks-- }()
 	if err := scopeCheckStatements(while.Block(), fe, ctx) ; err != nil {
 		return err
 	}
@@ -143,11 +202,29 @@ func scopeCheckWhile(while *ast.While, ctx *SemanticContext, fe *directories.Fun
 
 }	
 
+func scopeCheckSwitch(sw *ast.Switch, ctx *SemanticContext, fe *directories.FuncEntry) error {
+	//Check expression
+	if err := scopeCheckExpression(sw.Expression(), ctx, fe) ; err != nil {
+		return err
+	}
+
+	//Check the statements of every case, a break inside of them leaves the switch
+	ctx.breaks++
+	defer func() { ctx.breaks-- }()
+	for _, c := range sw.Cases() {
+		if err := scopeCheckStatements(c.Statements(), fe, ctx) ; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func scopeCheckFCall(fcall *ast.FunctionCall, ctx *SemanticContext, fe *directories.FuncEntry) error {
 	//Check func directory
 	if !ctx.FuncDir().Exists(fcall.Id()) {
 		if !IdIsReserved(fcall.Id()) {
-			return errutil.Newf("Function %s not declared.", fcall.Id())
+			return diagnostics.Errorf(ErrUndeclaredFunction, fcall.Token(), "Function %s not declared", fcall.Id())
 		}
 	}
 	//Check params
@@ -159,31 +236,70 @@ func scopeCheckFCall(fcall *ast.FunctionCall, ctx *SemanticContext, fe *director
 	return nil
 }
 
+func scopeCheckMethodCall(mcall *ast.ClassFunctionCall, ctx *SemanticContext, fe *directories.FuncEntry) error {
+	//Check the object in the local and global scope
+	ve := fe.VarDir().Get(mcall.Obj())
+	if ve == nil {
+		ve = ctx.Globals().Get(mcall.Obj())
+		if ve == nil {
+			return diagnostics.Errorf(ErrUndeclaredVariable, mcall.Token(), "Id %s not declared in local or global scope", mcall.Obj())
+		}
+	}
+	//Check the method in the class of the object
+	if !ve.Type().IsStruct() || ve.Type().List() > 0 || ve.Type().IsDynamic() || !ctx.FuncDir().Exists(methodKey(ve.Type().Struct().Name(), mcall.Id())) {
+		return diagnostics.Errorf(ErrUndeclaredFunction, mcall.Token(), "Method %s not declared for type %s", mcall.Id(), ve.Type().Name())
+	}
+	//Check params
+	for _, p := range mcall.Params() {
+		if err := scopeCheckExpression(p, ctx, fe); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func scopeCheckExpression(expr *ast.Expression, ctx *SemanticContext, fe *directories.FuncEntry) error {
 	if expr == nil {
 		return nil;
 	}
-	for _, e := range expr.Exps() {
-		for _, t := range e.Terms() {
-			for _, f := range t.Factors() {
-				if err := scopeCheckExpression(f.Expression(), ctx, fe); err != nil {
+	for _, f := range expr.Factors() {
+		if err := scopeCheckExpression(f.Expression(), ctx, fe); err != nil {
+			return err
+		}
+		if attr, ok := f.Constant().(*ast.Attribute); ok {
+			if err := scopeCheckAttribute(attr, ctx, fe); err != nil {
+				return err
+			}
+		} else if fc, ok := f.Constant().(*ast.FunctionCall); ok {
+			if err := scopeCheckFCall(fc, ctx, fe); err != nil {
+				return err
+			}
+		} else if mc, ok := f.Constant().(*ast.ClassFunctionCall); ok {
+			if err := scopeCheckMethodCall(mc, ctx, fe); err != nil {
+				return err
+			}
+		} else if le, ok := f.Constant().(*ast.ListElem); ok {
+			//Check if its inside the scope
+			if !fe.VarDir().Exists(le.Id()) {
+				//Check if its a global variable
+				if !ctx.Globals().Exists(le.Id()) {
+					return diagnostics.Errorf(ErrUndeclaredVariable, le.Token(), "Id %s not declared in local or global scope", le.Id())
+				}
+			}
+			for _, index := range le.Indexes() {
+				if err := scopeCheckExpression(index, ctx, fe); err != nil {
+					return err
+				}
+			}
+		} else if c, ok := f.Constant().(*ast.Cast); ok {
+			if err := scopeCheckExpression(c.Expression(), ctx, fe); err != nil {
+				return err
+			}
+		} else if ol, ok := f.Constant().(*ast.ObjectLiteral); ok {
+			for _, fi := range ol.Fields() {
+				if err := scopeCheckExpression(fi.Expression(), ctx, fe); err != nil {
 					return err
 				}
-				if _, ok := f.Constant().(*ast.ConstantValue); ok {
-					return nil
-				} else if attr, ok := f.Constant().(*ast.Attribute); ok {
-					return scopeCheckAttribute(attr, ctx, fe)
-				} else if fc, ok := f.Constant().(*ast.FunctionCall); ok {
-					return scopeCheckFCall(fc, ctx, fe)
-				} else if le, ok := f.Constant().(*ast.ListElem); ok {
-					//Check if its inside the scope
-					if !fe.VarDir().Exists(le.Id()) {
-						//Check if its a global variable
-						if !ctx.Globals().Exists(le.Id()) {
-							return errutil.Newf("Id %s not declared in local or global scope.", le.Id())
-						}
-					}
-				}		
 			}
 		}
 	}
@@ -193,20 +309,23 @@ func scopeCheckExpression(expr *ast.Expression, ctx *SemanticContext, fe *direct
 
 func scopeCheckAttribute(attr *ast.Attribute, ctx *SemanticContext, fe *directories.FuncEntry ) error{
 	//Check if objId is inside the scope
-	if !fe.VarDir().Exists(attr.ObjId()) {
+	ve := fe.VarDir().Get(attr.ObjId())
+	if ve == nil {
 		//Check if objId is a global variable
-		if !ctx.Globals().Exists(attr.ObjId()) {
-			return errutil.Newf("Id %s not declared in local or global scope.", attr.ObjId())
+		ve = ctx.Globals().Get(attr.ObjId())
+		if ve == nil {
+			return diagnostics.Errorf(ErrUndeclaredVariable, attr.Token(), "Id %s not declared in local or global scope", attr.ObjId())
 		}
 	}
 
-	if attr.Index() != nil {
-		if err := scopeCheckExpression(attr.Index(), ctx, fe); err != nil {
+	for _, index := range attr.Indexes() {
+		if err := scopeCheckExpression(index, ctx, fe); err != nil {
 			return err
 		}
 	}
 
-	if(attr.VarId()!= ""){
+	// The fields of a struct are checked with its type
+	if(attr.VarId()!= "" && !ve.Type().IsStruct()){
 		ok := false
 		for _, att := range ObjectAttributes {
 			if attr.VarId() == att {
@@ -215,7 +334,7 @@ func scopeCheckAttribute(attr *ast.Attribute, ctx *SemanticContext, fe *director
 		}
 
 		if !ok {
-			return errutil.Newf("Invalid object attribute %s", attr.VarId())
+			return diagnostics.Errorf(ErrInvalidAttribute, attr.Token(), "Invalid object attribute %s", attr.VarId())
 		}
 	}
 
@@ -227,7 +346,7 @@ func checkVarsReserved(vars *ast.Vars) error {
 	for _, res := range reservedFunctions {
 		for _, v := range vars.Variables() {
 			if v.Id() == res {
-				return errutil.Newf("Cannot declare variable as %v since it is a reserved function", v.Id())
+				return diagnostics.Errorf(ErrReservedName, v.Token(), "Cannot declare variable as %v since it is a reserved function", v.Id())
 			}
 		}
 	}
@@ -235,10 +354,14 @@ func checkVarsReserved(vars *ast.Vars) error {
 	return nil
 }
 
-func createVarsInFuncEntry(vars *ast.Vars, fe *directories.FuncEntry) error {
+func createVarsInFuncEntry(vars *ast.Vars, fe *directories.FuncEntry, ctx *SemanticContext) error {
 	if err := checkVarsReserved(vars); err != nil {
 		return err
 	}
+	// Every variable of the declaration shares the same type
+	if err := resolveStructType(vars.Variables()[0].Type(), vars.Token(), ctx); err != nil {
+		return err
+	}
 	if err := checkVarsInFuncEntry(vars, fe); err != nil {
 		return err
 	}
@@ -267,7 +390,7 @@ func checkVarsInGlobals(vars *ast.Vars, globals *directories.VarDirectory) error
 func checkVarsInFuncEntry(vars *ast.Vars, fe *directories.FuncEntry) error {
 	for _, v := range vars.Variables() {
 		if fe.VarDir().Exists(v.Id()) {
-			return errutil.Newf("Id %s already declared in local scope.", v.Id())
+			return diagnostics.Errorf(ErrRedeclaredVariable, v.Token(), "Id %s already declared in local scope", v.Id())
 		}
 	}
 
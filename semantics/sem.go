@@ -1,16 +1,52 @@
 package semantics
 
 import (
+	"sort"
+
 	"github.com/sdkvictor/golang-compiler/ast"
+	"github.com/sdkvictor/golang-compiler/diagnostics"
 	"github.com/sdkvictor/golang-compiler/directories"
+	"github.com/sdkvictor/golang-compiler/types"
+)
+
+// Codes of the diagnostics reported by the semantic analysis
+const (
+	ErrRedeclaredFunction = "E0101"
+	ErrRedeclaredVariable = "E0102"
+	ErrReservedName       = "E0103"
+	ErrRedeclaredType     = "E0104"
+	ErrUndeclaredFunction = "E0201"
+	ErrUndeclaredVariable = "E0202"
+	ErrInvalidAttribute   = "E0203"
+	ErrUndeclaredType     = "E0204"
+	ErrTypeMismatch       = "E0301"
+	ErrNonBoolCondition   = "E0302"
+	ErrInvalidOperation   = "E0303"
+	ErrInvalidIndex       = "E0304"
+	ErrArgumentCount      = "E0305"
+	ErrArgumentType       = "E0306"
+	ErrMain               = "E0307"
+	ErrSwitchType         = "E0308"
+	ErrNonConstantCase    = "E0309"
+	ErrDuplicateCase      = "E0310"
+	ErrFieldType          = "E0311"
+	ErrLossyConversion    = "E0312"
+	ErrConstantAssign     = "E0313"
+	ErrInvalidConstant    = "E0314"
+	ErrOutsideLoop        = "E0401"
 )
 
 //GenerationContext djsknfkjsdfkj
 type SemanticContext struct {
 	funcdir *directories.FuncDirectory
 	globals *directories.VarDirectory
+	structs map[string]*types.Struct
 	semcube *SemanticCube
 	hasMain bool
+	diags   diagnostics.List
+	invalid map[ast.Statement]bool
+	loops   int
+	breaks  int
 }
 
 // FuncDir ...
@@ -33,16 +69,35 @@ func (ctx *SemanticContext) HasMain() bool {
 }
 
 // SemanticCheck calls the 3 main functions that perform the semantic analysis and
-// reports any errors
+// reports any errors. Every error of the program is found before failing, and they are
+// returned as a diagnostics.List
 func SemanticCheck(program *ast.Program) (*directories.FuncDirectory, *directories.VarDirectory, error) {
 	funcdir := directories.NewFuncDirectory()
 	semcube := NewSemanticCube()
-	globals, err := directories.CreateVarDirectoryFromVarEntries(program.Vars())
-	if err != nil {
-		return nil, nil, err
-	}
+	globals := directories.NewVarDirectory()
+
+	ctx := &SemanticContext{funcdir, globals, make(map[string]*types.Struct), semcube, false, nil, make(map[ast.Statement]bool), 0, 0}
 
-	ctx := &SemanticContext{funcdir, globals, semcube, false}
+	// Build the struct directory and link every struct type outside of the functions to its declaration
+	// Errors to check:
+	//  * If a struct or a field of a struct is declared twice
+	//  * If a type is used that has not been declared
+	//
+	buildStructDirProgram(program, ctx)
+	resolveStructTypes(program, ctx)
+
+	// The declarations are added in the order of the source, so the redeclarations are the ones reported
+	vars := make([]*directories.VarEntry, len(program.Vars()))
+	copy(vars, program.Vars())
+	sort.SliceStable(vars, func(i, j int) bool {
+		return vars[i].Token().Pos.Offset < vars[j].Token().Pos.Offset
+	})
+
+	for _, ve := range vars {
+		if !globals.Add(ve) {
+			ctx.diags.Add(diagnostics.Errorf(ErrRedeclaredVariable, ve.Token(), "Id %s already declared in global scope", ve.Id()))
+		}
+	}
 
 	// Build the function directory and their corresponding Var directiories
 	// Errors to check:
@@ -50,7 +105,7 @@ func SemanticCheck(program *ast.Program) (*directories.FuncDirectory, *directori
 	//  * If two parameters in the same function have the same id
 	//
 	if err := buildFuncDirProgram(program, funcdir); err != nil {
-		return nil, nil, err
+		ctx.diags.Add(err)
 	}
 
 	// Check the scope of function calls and variable uses.
@@ -58,9 +113,7 @@ func SemanticCheck(program *ast.Program) (*directories.FuncDirectory, *directori
 	//  * If a function is called that does not exist
 	//  * If a variable is used and it has not been declared in the parameters
 	//
-	if err := scopeCheckProgram(program, ctx); err != nil {
-		return nil, nil, err
-	}
+	scopeCheckProgram(program, ctx)
 
 	// Check type cohesion
 	// Errors to check:
@@ -77,7 +130,10 @@ func SemanticCheck(program *ast.Program) (*directories.FuncDirectory, *directori
 	//  * To check whether a combination of params for an operator is valid, the semantic cube must be consulted
 	//
 	
-	if err := typeCheckProgram(program, ctx); err != nil {
+	typeCheckProgram(program, ctx)
+
+	ctx.diags.Sort()
+	if err := ctx.diags.Err(); err != nil {
 		return nil, nil, err
 	}
 
@@ -2,6 +2,7 @@ package semantics
 
 import (
 	"github.com/sdkvictor/golang-compiler/ast"
+	"github.com/sdkvictor/golang-compiler/diagnostics"
 	"github.com/sdkvictor/golang-compiler/gocc/lexer"
 	"github.com/sdkvictor/golang-compiler/gocc/parser"
 	//"github.com/davecgh/go-spew/spew"
@@ -67,4 +68,173 @@ func TestSemanticCheck(t *testing.T) {
 		}
 		//spew.Dump(funcdir)
 	}
-}
\ No newline at end of file
+}
+
+func TestSemanticDiagnostics(t *testing.T) {
+	p := parser.NewParser()
+	tests := []struct {
+		file    string
+		expects []string
+	}{
+		{"test/errors.vm", []string{
+			"5:9: error[E0102]: Id total already declared in global scope",
+			"9:9: error[E0302]: Condition must be of type bool, got int",
+			"12:16: error[E0305]: Function fact expected 1 arguments, got 2",
+			"17:9: error[E0312]: Cannot convert float to int implicitly in assignment, use int() to truncate it",
+			"18:5: error[E0202]: Id y not declared in local or global scope",
+			"19:21: error[E0303]: Invalid operation int + string",
+			"21:9: error[E0202]: Id values not declared in local or global scope",
+		}},
+		{"test/loopcontrol.vm", []string{
+			"11:9: error[E0401]: break statement outside of a loop or switch",
+			"19:5: error[E0401]: continue statement outside of a loop",
+		}},
+		{"test/switch.vm", []string{
+			"10:13: error[E0308]: Switch expression must be of type int, char or string, got float",
+			"15:14: error[E0309]: Case value must be a constant",
+			"17:14: error[E0301]: Case value of type char does not match switch type int",
+			"19:17: error[E0310]: Duplicate case 4 in switch",
+			"23:9: error[E0310]: Switch has more than one default case",
+		}},
+		{"test/structs.vm", []string{
+			"6:12: error[E0311]: Field lives of struct Player must be of type int, float, bool, char or string",
+			"7:11: error[E0102]: Field x already declared in struct Player",
+			"10:8: error[E0104]: Type Player already declared",
+			"16:11: error[E0204]: Type Enemy not declared",
+			"20:23: error[E0203]: Field health does not exist in struct Player",
+			"25:11: error[E0301]: Expression of type bool does not match variable type float in assignment",
+			"26:5: error[E0203]: Array team must be indexed before accessing field score",
+			"27:9: error[E0301]: Expression of type int does not match variable type Player in assignment",
+			"28:5: error[E0203]: Field health does not exist in struct Player",
+		}},
+		{"test/classes.vm", []string{
+			"7:7: error[E0311]: Class Sprite can only embed a Square or a Circle, got Image",
+			"16:9: error[E0203]: Field speed does not exist in class Player",
+			"27:5: error[E0305]: Method move of Player expected 2 arguments, got 1",
+			"28:12: error[E0306]: In method call move, expected type float for position 1, got char",
+			"29:5: error[E0201]: Method jump not declared for type Player",
+			"30:5: error[E0201]: Method move not declared for type int",
+			"31:12: error[E0306]: Cannot pass Point to Render, it does not embed an object",
+		}},
+		{"test/arraysize.vm", []string{
+			"4:9: error[E0001]: Cannot declare array of size less than 1",
+		}},
+		{"test/structsize.vm", []string{
+			"8:7: error[E0001]: Cannot declare array of size less than 1",
+		}},
+		{"test/unterminated.vm", []string{
+			"8:16: error[E0001]: Unterminated string",
+		}},
+		{"test/listtype.vm", []string{
+			"4:13: error[E0001]: Invalid type for list. Expected list<T>",
+		}},
+		{"test/structsizeexp.vm", []string{
+			"8:7: error[E0001]: Array size must be an int constant",
+		}},
+		{"test/grids.vm", []string{
+			"14:5: error[E0304]: Array grid has 2 dimensions, got 1 indexes",
+			"15:13: error[E0304]: Array grid has 2 dimensions, got 3 indexes",
+			"16:5: error[E0304]: Variable count is not array, cannot index",
+			"17:13: error[E0304]: Index for array must be type integer",
+			"18:17: error[E0306]: In function call sum, expected type int[3][4] for position 1, got int[4][3]",
+		}},
+		{"test/lists.vm", []string{
+			"5:15: error[E0311]: Field history of struct Point must be of type int, float, bool, char or string",
+			"15:21: error[E0306]: Cannot append float to list<int>",
+			"16:12: error[E0306]: In function call append, expected a list for position 1, got int",
+			"17:21: error[E0306]: In function call remove, expected type int for position 2, got char",
+			"18:13: error[E0305]: Reserved function len requires 1 args, 2 given",
+			"19:13: error[E0304]: List numbers has 1 dimension, got 2 indexes",
+			"20:13: error[E0203]: Cannot access attribute x of list<Square>",
+			"21:12: error[E0306]: Cannot pass list<Square> to Render",
+			"22:13: error[E0301]: Expression of type list<int> does not match variable type int in assignment",
+			"23:19: error[E0306]: Cannot append list<int> to list<Square>",
+		}},
+		{"test/casts.vm", []string{
+			"12:5: error[E0312]: Cannot convert float to int implicitly in return, use int() to truncate it",
+			"21:9: error[E0312]: Cannot convert float to int implicitly in assignment, use int() to truncate it",
+			"22:10: error[E0312]: Cannot convert float to int implicitly in function call take, use int() to truncate it",
+			"23:9: error[E0303]: Cannot cast string to int",
+			"24:9: error[E0303]: Cannot cast float to char",
+			"25:9: error[E0303]: Cannot cast char to float",
+			"26:9: error[E0303]: Cannot cast int[3] to int",
+			"28:15: error[E0303]: Invalid operation char + int",
+		}},
+		{"test/strings.vm", []string{
+			"11:20: error[E0303]: Invalid operation string + char",
+			"12:14: error[E0304]: Index for string must be type integer",
+			"13:9: error[E0304]: String word has 1 dimension, got 2 indexes",
+			"14:5: error[E0304]: Cannot assign to a character of string word",
+			"15:12: error[E0305]: Reserved function substr requires 3 args, 2 given",
+			"16:23: error[E0306]: In function call indexOf, expected type string or char for position 2, got int",
+			"17:21: error[E0306]: In function call toString, expected type int, float, bool or char for position 1, got string",
+			"18:18: error[E0306]: In function call parseInt, expected type string for position 1, got int",
+			"20:9: error[E0301]: Expression of type char does not match variable type int in assignment",
+			"21:13: error[E0306]: In function call len, expected a list or a string for position 1, got float",
+		}},
+		{"test/constants.vm", []string{
+			"5:17: error[E0202]: Id later not declared in local or global scope",
+			"7:18: error[E0314]: Constant SIZES cannot be of type int[3]",
+			"16:21: error[E0314]: Value of a constant must be known at compile time, n is not a constant",
+			"17:22: error[E0314]: Value of a constant must be known at compile time",
+			"18:28: error[E0314]: Division by zero in constant",
+			"19:16: error[E0202]: Id self not declared in local or global scope",
+			"20:17: error[E0312]: Cannot convert float to int implicitly in assignment, use int() to truncate it",
+			"21:31: error[E0203]: Attribute x is set more than once",
+			"22:22: error[E0301]: Expression of type Circle does not match variable type Square in assignment",
+			"23:25: error[E0301]: Expression of type string does not match type float of attribute size",
+			"24:23: error[E0203]: Attribute size does not exist for object type Circle",
+			"25:5: error[E0313]: Cannot assign to constant MAX",
+			"26:10: error[E0313]: Cannot assign to constant MAX",
+		}},
+		{"test/compound.vm", []string{
+			"12:10: error[E0312]: Cannot convert float to int implicitly in assignment, use int() to truncate it",
+			"13:10: error[E0312]: Cannot convert float to int implicitly in assignment, use int() to truncate it",
+			"14:13: error[E0303]: Invalid operation bool + int",
+			"15:9: error[E0303]: Invalid operation bool + int",
+			"16:13: error[E0303]: Invalid operation string - string",
+			"17:5: error[E0304]: Cannot assign to a character of string word",
+			"18:13: error[E0303]: Invalid operation list<int> + int",
+			"19:5: error[E0313]: Cannot assign to constant MAX",
+			"20:5: error[E0313]: Cannot assign to constant MAX",
+			"21:10: error[E0303]: Invalid operation int + bool",
+			"22:5: error[E0202]: Id undeclared not declared in local or global scope",
+		}},
+	}
+
+	for _, test := range tests {
+		input, err := readFile(test.file)
+		if err != nil {
+			t.Fatalf("Error reading file %s", test.file)
+		}
+
+		// A syntax error stops the parser before the semantic check
+		pro, err := p.Parse(lexer.NewLexer(input))
+		if err != nil {
+			err = diagnostics.FromParseError(err)
+		} else {
+			program, ok := pro.(*ast.Program)
+			if !ok {
+				t.Fatalf("Cannot cast to Program")
+			}
+
+			_, _, err = SemanticCheck(program)
+		}
+
+		diags, ok := err.(diagnostics.List)
+		if !ok {
+			t.Fatalf("%s: Expected a list of diagnostics, got %v", test.file, err)
+		}
+
+		if len(diags) != len(test.expects) {
+			t.Errorf("%s: Expected %d diagnostics, got %d:\n%v", test.file, len(test.expects), len(diags), diags)
+			continue
+		}
+
+		for i, d := range diags {
+			if d.Error() != test.expects[i] {
+				t.Errorf("%s: Expected %q, got %q", test.file, test.expects[i], d.Error())
+			}
+		}
+	}
+}
@@ -16,6 +16,15 @@ var reservedFunctions = []string{
     "Render",
     "Clear",
 	"Update",
+	"append",
+	"len",
+	"remove",
+	"clear",
+	"substr",
+	"indexOf",
+	"toString",
+	"parseInt",
+	"parseFloat",
 }
 
 var reservedFunctionsReturn = map[string]*types.Type{
@@ -78,8 +87,11 @@ const (
 	Sub
 	Mult
 	Div
+	Mod
 	Lt
 	Gt
+	LtEqual
+	GtEqual
 	Assign
 	Equal
 	NotEqual
@@ -98,10 +110,16 @@ func (o Operation) convert() string {
 		return "*"
 	case Div:
 		return "/"
+	case Mod:
+		return "%"
 	case Lt:
 		return "<"
 	case Gt:
 		return ">"
+	case LtEqual:
+		return "<="
+	case GtEqual:
+		return ">="
 	case Equal:
 		return "=="
 	case NotEqual:
@@ -131,10 +149,16 @@ func GetOperation(s string) Operation {
 		return Mult
 	case "/":
 		return Div
+	case "%":
+		return Mod
 	case "<":
 		return Lt
 	case ">":
 		return Gt
+	case "<=":
+		return LtEqual
+	case ">=":
+		return GtEqual
 	case "==":
 		return Equal
 	case "!=":
@@ -167,16 +191,54 @@ func NewSemanticCube() *SemanticCube {
 			"-@44": types.Int,
 			"/@44": types.Int,
 			"*@44": types.Int,
+			"%@44": types.Int,
 			"+@11": types.Float,
 			"-@11": types.Float,
 			"/@11": types.Float,
 			"*@11": types.Float,
+			"%@11": types.Float,
+			"+@55": types.String,
+			// An int operated with a float is promoted to float
+			"+@41": types.Float,
+			"+@14": types.Float,
+			"-@41": types.Float,
+			"-@14": types.Float,
+			"*@41": types.Float,
+			"*@14": types.Float,
+			"/@41": types.Float,
+			"/@14": types.Float,
+			"%@41": types.Float,
+			"%@14": types.Float,
 			
-			//Relational Operators
+			//Relational Operators, chars and strings are compared by their characters
 			"<@44": types.Bool,
 			">@44": types.Bool,
+			"<=@44": types.Bool,
+			">=@44": types.Bool,
 			"<@11": types.Bool,
 			">@11": types.Bool,
+			"<=@11": types.Bool,
+			">=@11": types.Bool,
+			"<@22": types.Bool,
+			">@22": types.Bool,
+			"<=@22": types.Bool,
+			">=@22": types.Bool,
+			"<@55": types.Bool,
+			">@55": types.Bool,
+			"<=@55": types.Bool,
+			">=@55": types.Bool,
+			"<@41": types.Bool,
+			"<@14": types.Bool,
+			">@41": types.Bool,
+			">@14": types.Bool,
+			"<=@41": types.Bool,
+			"<=@14": types.Bool,
+			">=@41": types.Bool,
+			">=@14": types.Bool,
+			"==@41": types.Bool,
+			"==@14": types.Bool,
+			"!=@41": types.Bool,
+			"!=@14": types.Bool,
 			"==@44": types.Bool,
 			"==@11": types.Bool,
 			"==@33": types.Bool,
@@ -191,6 +253,8 @@ func NewSemanticCube() *SemanticCube {
 			"&&@33": types.Bool,
 			"||@33":  types.Bool,
 			"!@3":    types.Bool,
+			"-@4":    types.Int,
+			"-@1":    types.Float,
 		},
 	}
 }
@@ -221,6 +285,10 @@ func isOperationFromSemanticCube(s string) bool {
 		return true
 	case ">":
 		return true
+	case "<=":
+		return true
+	case ">=":
+		return true
 	case "==":
 		return true
 	case "!=":
@@ -0,0 +1,85 @@
+package semantics
+
+import (
+	"github.com/sdkvictor/golang-compiler/ast"
+	"github.com/sdkvictor/golang-compiler/diagnostics"
+	"github.com/sdkvictor/golang-compiler/directories"
+	"github.com/sdkvictor/golang-compiler/types"
+)
+
+// stringFunctionsParamAmount are the builtins of the strings, len is shared with the lists
+var stringFunctionsParamAmount = map[string]int{
+	"substr":     3,
+	"indexOf":    2,
+	"toString":   1,
+	"parseInt":   1,
+	"parseFloat": 1,
+}
+
+var stringFunctionsReturn = map[string]*types.Type{
+	"substr":     types.NewDataType(types.String, 0, 0),
+	"indexOf":    types.NewDataType(types.Int, 0, 0),
+	"toString":   types.NewDataType(types.String, 0, 0),
+	"parseInt":   types.NewDataType(types.Int, 0, 0),
+	"parseFloat": types.NewDataType(types.Float, 0, 0),
+}
+
+// IsStringFunction tells if the id is one of the builtins of the strings
+func IsStringFunction(id string) bool {
+	_, ok := stringFunctionsParamAmount[id]
+	return ok
+}
+
+func GetStringFunctionReturnType(id string) *types.Type {
+	t, _ := stringFunctionsReturn[id]
+	return t
+}
+
+// isString tells if the type is a single string, not an array or a list of them
+func isString(t *types.Type) bool {
+	return t.Equal(types.NewDataType(types.String, 0, 0))
+}
+
+//typeCheckStringFunction checks the call of a builtin of the strings. substr takes the start and the length
+// of the substring, indexOf looks for a string or a char and toString converts any basic type but string
+func typeCheckStringFunction(fc *ast.FunctionCall, ctx *SemanticContext, fe *directories.FuncEntry) (*types.Type, error) {
+	if a := stringFunctionsParamAmount[fc.Id()]; len(fc.Params()) != a {
+		return nil, diagnostics.Errorf(ErrArgumentCount, fc.Token(), "Reserved function %s requires %d args, %d given", fc.Id(), a, len(fc.Params()))
+	}
+
+	argTypes := make([]*types.Type, 0, len(fc.Params()))
+	for _, param := range fc.Params() {
+		t, err := typeCheckExpression(param, ctx, fe)
+		if err != nil {
+			return nil, err
+		}
+		argTypes = append(argTypes, t)
+	}
+
+	inttype := types.NewDataType(types.Int, 0, 0)
+	chartype := types.NewDataType(types.Char, 0, 0)
+
+	for i, t := range argTypes {
+		ok := true
+		expected := "string"
+		switch {
+		case fc.Id() == "toString":
+			ok = t.Equal(inttype) || t.Equal(types.NewDataType(types.Float, 0, 0)) || t.Equal(types.NewDataType(types.Bool, 0, 0)) || t.Equal(chartype)
+			expected = "int, float, bool or char"
+		case fc.Id() == "substr" && i > 0:
+			ok = t.Equal(inttype)
+			expected = "int"
+		case fc.Id() == "indexOf" && i > 0:
+			ok = isString(t) || t.Equal(chartype)
+			expected = "string or char"
+		default:
+			ok = isString(t)
+		}
+
+		if !ok {
+			return nil, diagnostics.Errorf(ErrArgumentType, fc.Params()[i].Token(), "In function call %s, expected type %s for position %v, got %s", fc.Id(), expected, i+1, t.Name())
+		}
+	}
+
+	return GetStringFunctionReturnType(fc.Id()), nil
+}
@@ -0,0 +1,102 @@
+package semantics
+
+import (
+	"sort"
+
+	"github.com/sdkvictor/golang-compiler/ast"
+	"github.com/sdkvictor/golang-compiler/diagnostics"
+	"github.com/sdkvictor/golang-compiler/directories"
+	"github.com/sdkvictor/golang-compiler/gocc/token"
+	"github.com/sdkvictor/golang-compiler/types"
+)
+
+//buildStructDirProgram adds every struct declaration of the program to the struct directory of the context,
+// reporting the redeclared structs and the invalid fields
+func buildStructDirProgram(program *ast.Program, ctx *SemanticContext) {
+	for _, s := range program.Structs() {
+		st := types.NewStruct(s.Id())
+		if s.IsClass() {
+			st.SetClass()
+		}
+
+		if _, ok := ctx.structs[s.Id()]; ok {
+			ctx.diags.Add(diagnostics.Errorf(ErrRedeclaredType, s.Token(), "Type %s already declared", s.Id()))
+			continue
+		}
+
+		if IdIsReserved(s.Id()) {
+			ctx.diags.Add(diagnostics.Errorf(ErrReservedName, s.Token(), "Cannot declare a %s with reserved keyword %s", st.Kind(), s.Id()))
+			continue
+		}
+
+		// A class embeds an object the engine knows how to draw and collide
+		if e := s.Embed(); e != nil {
+			if e.Object() != types.Square && e.Object() != types.Circle {
+				ctx.diags.Add(diagnostics.Errorf(ErrFieldType, s.Token(), "Class %s can only embed a Square or a Circle, got %s", s.Id(), e.Name()))
+			} else {
+				st.Embed(e.Object())
+			}
+		}
+
+		// The fields keep the order of the source, it defines their offset in memory
+		fields := make([]*directories.VarEntry, len(s.Fields()))
+		copy(fields, s.Fields())
+		sort.SliceStable(fields, func(i, j int) bool {
+			return fields[i].Token().Pos.Offset < fields[j].Token().Pos.Offset
+		})
+
+		for _, f := range fields {
+			t := f.Type()
+			if t.IsObject() || t.IsStruct() || t.List() > 0 || t.IsDynamic() {
+				ctx.diags.Add(diagnostics.Errorf(ErrFieldType, f.Token(), "Field %s of %s %s must be of type int, float, bool, char or string", f.Id(), st.Kind(), s.Id()))
+				continue
+			}
+			if !st.AddField(f.Id(), t) {
+				ctx.diags.Add(diagnostics.Errorf(ErrRedeclaredVariable, f.Token(), "Field %s already declared in %s %s", f.Id(), st.Kind(), s.Id()))
+			}
+		}
+
+		ctx.structs[s.Id()] = st
+	}
+}
+
+//methodKey is the key of the function of a method in the function directory
+func methodKey(class, method string) string {
+	return class + "." + method
+}
+
+//resolveStructTypes links the struct types of the globals, the parameters and the return types
+// to their declaration, so their size is known before any memory is assigned
+func resolveStructTypes(program *ast.Program, ctx *SemanticContext) {
+	for _, ve := range program.Vars() {
+		if err := resolveStructType(ve.Type(), ve.Token(), ctx); err != nil {
+			ctx.diags.Add(err)
+		}
+	}
+
+	for _, f := range program.Functions() {
+		if err := resolveStructType(f.Type(), f.Token(), ctx); err != nil {
+			ctx.diags.Add(err)
+		}
+		for _, p := range f.Params() {
+			if err := resolveStructType(p.Type(), p.Token(), ctx); err != nil {
+				ctx.diags.Add(err)
+			}
+		}
+	}
+}
+
+//resolveStructType replaces the struct of the type with the declared one, the other types are left as they are
+func resolveStructType(t *types.Type, tok *token.Token, ctx *SemanticContext) error {
+	if !t.IsStruct() {
+		return nil
+	}
+
+	st, ok := ctx.structs[t.Struct().Name()]
+	if !ok {
+		return diagnostics.Errorf(ErrUndeclaredType, tok, "Type %s not declared", t.Struct().Name())
+	}
+
+	t.SetStruct(st)
+	return nil
+}
@@ -2,24 +2,36 @@ package semantics
 
 import (
 	"github.com/sdkvictor/golang-compiler/ast"
+	"github.com/sdkvictor/golang-compiler/diagnostics"
 	"github.com/sdkvictor/golang-compiler/directories"
+	"github.com/sdkvictor/golang-compiler/gocc/token"
 	"github.com/sdkvictor/golang-compiler/types"
 	"github.com/mewkiz/pkg/errutil"
 )
 
-//typeCheckProgram starts the type checking in the whole program
-func typeCheckProgram(program *ast.Program, ctx *SemanticContext) error {
+//typeCheckProgram starts the type checking in the whole program, the errors of every function are
+// added to the diagnostics of the context
+func typeCheckProgram(program *ast.Program, ctx *SemanticContext) {
+	scope := GlobalScope()
+	for _, decl := range program.Decls() {
+		if ctx.invalid[decl] {
+			continue
+		}
+
+		if err := typeCheckVars(decl, ctx, scope); err != nil {
+			ctx.diags.Add(err)
+		}
+	}
+
 	for _, f := range program.Functions() {
 		if err := typeCheckFunction(f, ctx); err != nil {
-			return err
+			ctx.diags.Add(err)
 		}
 	}
 
 	if !ctx.HasMain() {
-		return errutil.Newf("Main function not declared in program")
+		ctx.diags.Add(diagnostics.Errorf(ErrMain, nil, "Main function not declared in program"))
 	}
-
-	return nil
 }
 
 //typeCheckFunction verifies its statement is of the same type of the return type of the function
@@ -27,19 +39,20 @@ func typeCheckFunction(function *ast.Function, ctx *SemanticContext) error {
 
 	fe := ctx.FuncDir().Get(function.Key())
 	if fe == nil {
-		return errutil.NewNoPosf("%+v: Cannot get function %s from Func Directory", function.Token(), function.Id())
+		// The function could not be added to the directory, which was already reported
+		return nil
 	}
 
 	// Check que main tenga return void y sin parametros
 	if fe.Id() == "main" {
+		ctx.hasMain = true
+
 		if fe.ReturnType().String() != "6" {
-			return errutil.Newf("Main function must be return type void")
+			ctx.diags.Add(diagnostics.Errorf(ErrMain, function.Token(), "Main function must be return type void"))
 		}
 		if len(fe.Params()) > 0 {
-			return errutil.Newf("Main function must not have parameters")
+			ctx.diags.Add(diagnostics.Errorf(ErrMain, function.Token(), "Main function must not have parameters"))
 		}
-
-		ctx.hasMain = true
 	}
 
 	if err := typeCheckStatements(function.Statements(), ctx, fe); err != nil {
@@ -49,10 +62,15 @@ func typeCheckFunction(function *ast.Function, ctx *SemanticContext) error {
 	return nil
 }
 
+// typeCheckStatements checks every statement that passed the scope check, reporting their errors
 func typeCheckStatements(statements []ast.Statement, ctx *SemanticContext, fe *directories.FuncEntry) error {
 	for _, s := range statements {
+		if ctx.invalid[s] {
+			continue
+		}
+
 		if err := typeCheckStatement(s, fe, ctx); err != nil {
-			return err
+			ctx.diags.Add(err)
 		}
 	}
 
@@ -60,12 +78,14 @@ func typeCheckStatements(statements []ast.Statement, ctx *SemanticContext, fe *d
 }
 
 func typeCheckStatement(statement ast.Statement, fe *directories.FuncEntry, ctx *SemanticContext) error {
-	if _, ok := statement.(*ast.Vars); ok {
-		return nil
+	if vars, ok := statement.(*ast.Vars); ok {
+		return typeCheckVars(vars, ctx, fe)
 	} else if assign, ok := statement.(*ast.Assign); ok {
 		return typeCheckAssign(assign, ctx, fe)
 	} else if condition, ok := statement.(*ast.Condition); ok {
 		return typeCheckCondition(condition, ctx, fe)
+	} else if sw, ok := statement.(*ast.Switch); ok {
+		return typeCheckSwitch(sw, ctx, fe)
 	} else if write, ok := statement.(*ast.Write); ok {
 		return typeCheckWrite(write, ctx, fe)
 	} else if ret, ok := statement.(*ast.Return); ok {
@@ -77,12 +97,58 @@ func typeCheckStatement(statement ast.Statement, fe *directories.FuncEntry, ctx
 	} else if fcall, ok := statement.(*ast.FunctionCall); ok {
 		_, err := typeCheckFunctionCall(fcall, ctx, fe)
 		return err
+	} else if mcall, ok := statement.(*ast.ClassFunctionCall); ok {
+		_, err := typeCheckMethodCall(mcall, ctx, fe)
+		return err
+	} else if _, ok := statement.(*ast.Break); ok {
+		return nil
+	} else if _, ok := statement.(*ast.Continue); ok {
+		return nil
 	}
 
 	return errutil.Newf("Statement cannot be casted to any valid form")
 }
 
+//typeCheckVars checks the initial value of the declaration like an assignment, the value of a constant is
+// also folded
+func typeCheckVars(vars *ast.Vars, ctx *SemanticContext, fe *directories.FuncEntry) error {
+	if vars.Init() == nil {
+		return nil
+	}
+
+	ve := vars.Variables()[0]
+	if ve.IsConstant() {
+		if err := typeCheckConstantType(ve); err != nil {
+			return err
+		}
+	}
+
+	if err := typeCheckAssignValue(vars.Init(), ctx, fe); err != nil {
+		return err
+	}
+
+	if !ve.IsConstant() {
+		return nil
+	}
+
+	return foldConstantDec(ve, vars.Init().Expression(), ctx, fe)
+}
+
 func typeCheckAssign(assign *ast.Assign, ctx *SemanticContext, fe *directories.FuncEntry) error {
+	ve := fe.VarDir().Get(assign.Attribute().ObjId())
+	if ve == nil {
+		ve = ctx.Globals().Get(assign.Attribute().ObjId())
+	}
+
+	if ve != nil && ve.IsConstant() {
+		return diagnostics.Errorf(ErrConstantAssign, assign.Attribute().Token(), "Cannot assign to constant %s", ve.Id())
+	}
+
+	return typeCheckAssignValue(assign, ctx, fe)
+}
+
+//typeCheckAssignValue checks the value can be stored in the variable, element or attribute of the assignment
+func typeCheckAssignValue(assign *ast.Assign, ctx *SemanticContext, fe *directories.FuncEntry) error {
 	tAtt, err := typeCheckAttribute(assign.Attribute(), ctx, fe); 
 	if err != nil {
 		return err
@@ -92,9 +158,21 @@ func typeCheckAssign(assign *ast.Assign, ctx *SemanticContext, fe *directories.F
 	if err != nil {
 		return err
 	}
+
+	// A compound assignment stores the result of operating the target with the expression
+	if assign.Operation() != "" {
+		tExp, err = typeCheckOperation(assign.Operation(), tAtt, tExp, assign.Expression().Token(), ctx)
+		if err != nil {
+			return err
+		}
+	}
 	
-	if !tAtt.Equal(tExp) {
-		return errutil.Newf("%+v: Expression does not match variable type in assignment", assign.Token())
+	if err := typeCheckLossyConversion(tExp, tAtt, assign.Expression().Token(), "assignment"); err != nil {
+		return err
+	}
+
+	if !tAtt.Equal(tExp) && !IsPromotion(tExp, tAtt) {
+		return diagnostics.Errorf(ErrTypeMismatch, assign.Expression().Token(), "Expression of type %s does not match variable type %s in assignment", tExp.Name(), tAtt.Name())
 	}
 
 	return nil
@@ -107,7 +185,7 @@ func typeCheckCondition(condition *ast.Condition, ctx *SemanticContext , fe *dir
 	}
 
 	if tExp.Basic() != types.Bool {
-		return errutil.Newf("Expression does not match variable type in condition in %+v", condition.Token())
+		return diagnostics.Errorf(ErrNonBoolCondition, condition.Expression().Token(), "Condition must be of type bool, got %s", tExp.Name())
 	}
 
 	err = typeCheckStatements(condition.Statements(), ctx, fe)
@@ -123,6 +201,57 @@ func typeCheckCondition(condition *ast.Condition, ctx *SemanticContext , fe *dir
 
 }
 
+// typeCheckSwitch verifies the switch is over an int, char or string and its cases are distinct constants
+// of the same type
+func typeCheckSwitch(sw *ast.Switch, ctx *SemanticContext , fe *directories.FuncEntry) error {
+	tExp, err := typeCheckExpression(sw.Expression(), ctx, fe)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case tExp.List() > 0 || tExp.IsObject() || tExp.IsDynamic():
+		return diagnostics.Errorf(ErrSwitchType, sw.Expression().Token(), "Switch expression must be of type int, char or string, got %s", tExp.Name())
+	case tExp.Basic() != types.Int && tExp.Basic() != types.Char && tExp.Basic() != types.String:
+		return diagnostics.Errorf(ErrSwitchType, sw.Expression().Token(), "Switch expression must be of type int, char or string, got %s", tExp.Name())
+	}
+
+	seen := make(map[string]bool)
+	hasDefault := false
+	for _, c := range sw.Cases() {
+		if c.IsDefault() {
+			if hasDefault {
+				ctx.diags.Add(diagnostics.Errorf(ErrDuplicateCase, c.Token(), "Switch has more than one default case"))
+			}
+			hasDefault = true
+		}
+
+		for _, v := range c.Values() {
+			cv, ok := v.Constant().(*ast.ConstantValue)
+			if !ok {
+				ctx.diags.Add(diagnostics.Errorf(ErrNonConstantCase, v.Token(), "Case value must be a constant"))
+				continue
+			}
+
+			if !cv.Type().Equal(tExp) {
+				ctx.diags.Add(diagnostics.Errorf(ErrTypeMismatch, v.Token(), "Case value of type %s does not match switch type %s", cv.Type().Name(), tExp.Name()))
+				continue
+			}
+
+			if seen[cv.Value()] {
+				ctx.diags.Add(diagnostics.Errorf(ErrDuplicateCase, v.Token(), "Duplicate case %s in switch", cv.Value()))
+			}
+			seen[cv.Value()] = true
+		}
+
+		if err := typeCheckStatements(c.Statements(), ctx, fe); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func typeCheckWrite(write *ast.Write, ctx *SemanticContext , fe *directories.FuncEntry) error {
 	_, err := typeCheckExpression(write.Expression(), ctx, fe)
 	if err != nil {
@@ -138,8 +267,12 @@ func typeCheckReturn(ret *ast.Return, ctx *SemanticContext , fe *directories.Fun
 		return err
 	}
 	
-	if !tExp.Equal(fe.ReturnType()) {
-		return errutil.Newf("Expression does not match function type in return in %+v", ret.Token())
+	if err := typeCheckLossyConversion(tExp, fe.ReturnType(), ret.Token(), "return"); err != nil {
+		return err
+	}
+
+	if !tExp.Equal(fe.ReturnType()) && !IsPromotion(tExp, fe.ReturnType()) {
+		return diagnostics.Errorf(ErrTypeMismatch, ret.Token(), "Expression of type %s does not match function type %s in return", tExp.Name(), fe.ReturnType().Name())
 	}
 
 	return nil
@@ -157,7 +290,7 @@ func typeCheckFor(f *ast.For, ctx *SemanticContext , fe *directories.FuncEntry)
 	}
 	
 	if tCond.Basic() != types.Bool {
-		return errutil.Newf("Expression does not match variable type in for condition in %+v", f.Token())
+		return diagnostics.Errorf(ErrNonBoolCondition, f.Condition().Token(), "Condition must be of type bool, got %s", tCond.Name())
 	}
 
 	err = typeCheckAssign(f.Operation(), ctx, fe)
@@ -179,7 +312,7 @@ func typeCheckWhile(while *ast.While, ctx *SemanticContext , fe *directories.Fun
 	}
 
 	if tExp.Basic() != types.Bool {
-		return errutil.Newf("Expression does not match variable type in while condition in %+v", while.Token())
+		return diagnostics.Errorf(ErrNonBoolCondition, while.Expression().Token(), "Condition must be of type bool, got %s", tExp.Name())
 	}
 
 	
@@ -199,119 +332,222 @@ func typeCheckAttribute(att *ast.Attribute, ctx *SemanticContext, fe *directorie
 		}
 	}
 
-	// Checar que no pueda existir un VarId y un Index al mismo tiempo
-	if att.VarId() != "" && att.Index() != nil {
-		return nil, errutil.Newf("%+v: Cannot index object attribute", att.Token())
+	if ve.Type().IsDynamic() && att.VarId() != "" && len(att.Indexes()) == 0 {
+		return nil, diagnostics.Errorf(ErrInvalidAttribute, att.Token(), "Cannot access attribute %s of %s", att.VarId(), ve.Type().Name())
 	}
 
-	// Checar si se esta indexando una variable que no es arreglo
-	if ve.Type().List() < 1 && att.Index() != nil {
-		return nil, errutil.Newf("%+v: Variable %s is not array, cannot index", att.Token(), att.ObjId())
+	// Los indices de un arreglo solo se asignan en arreglos y listas, un string no puede cambiar
+	if len(att.Indexes()) > 0 && isString(ve.Type()) {
+		return nil, diagnostics.Errorf(ErrInvalidIndex, att.Token(), "Cannot assign to a character of string %s", att.ObjId())
 	}
 
-	// Caso donde es variable indexada
-	if att.Index() != nil {
-		t, err := typeCheckExpression(att.Index(), ctx, fe)
+	t := ve.Type()
+	// Caso donde es variable indexada, el campo es del elemento
+	if len(att.Indexes()) > 0 {
+		elem, err := typeCheckIndexes(att.Indexes(), ve, att.Token(), ctx, fe)
 		if err != nil {
 			return nil, err
 		}
-
-		if t.Basic() != types.Int {
-			return nil, errutil.Newf("%+v: Cannot use non-integer as index in array", att.Token())
-		}
-
-		nt := ve.Type().Copy()
-		nt.DecreaseList()
-
-		return nt, nil
+		t = elem
 	}
 
 	// Caso donde es variable normal sin object attribute
 	if att.VarId() == "" {
-		return ve.Type(), nil
+		return t, nil
+	}
+
+	// Only the elements of an array have fields and attributes
+	if t.List() > 0 && t.IsStruct() {
+		return nil, diagnostics.Errorf(ErrInvalidAttribute, att.Token(), "Array %s must be indexed before accessing field %s", att.ObjId(), att.VarId())
+	} else if t.List() > 0 {
+		return nil, diagnostics.Errorf(ErrInvalidAttribute, att.Token(), "Array %s must be indexed before accessing attribute %s", att.ObjId(), att.VarId())
+	}
+
+	if t.IsStruct() {
+		// Caso donde es un campo de un struct
+		field := t.Struct().Field(att.VarId())
+		if field != nil {
+			return field.Type(), nil
+		}
+		// The attributes of an embedded object are used as fields of the class
+		if embedded := t.Struct().Embedded(); embedded != nil && matchTypeWithAttr(embedded.Type(), att.VarId()) {
+			return GetObjectAttributeType(att.VarId()), nil
+		}
+		return nil, diagnostics.Errorf(ErrInvalidAttribute, att.Token(), "Field %s does not exist in %s %s", att.VarId(), t.Struct().Kind(), t.Name())
 	} else {
 		// Caso donde es un object attribute
-		if ok := matchTypeWithAttr(ve.Type(), att.VarId()); !ok {
-			return nil, errutil.Newf("%+v: Attribute %s does not exist for object type %s", att.Token(), att.VarId(), ve.Type())
+		if ok := matchTypeWithAttr(t, att.VarId()); !ok {
+			return nil, diagnostics.Errorf(ErrInvalidAttribute, att.Token(), "Attribute %s does not exist for object type %s", att.VarId(), t.Name())
 		}
-		t := GetObjectAttributeType(att.VarId())
-		return t, nil
+		return GetObjectAttributeType(att.VarId()), nil
+	}
+}
+
+//typeCheckObjectLiteral verifies every attribute of the literal exists for its object type, is set once
+// and gets a value of the type of the attribute
+func typeCheckObjectLiteral(ol *ast.ObjectLiteral, ctx *SemanticContext, fe *directories.FuncEntry) (*types.Type, error) {
+	seen := make(map[string]bool)
+	for _, fi := range ol.Fields() {
+		if !matchTypeWithAttr(ol.Type(), fi.Id()) {
+			return nil, diagnostics.Errorf(ErrInvalidAttribute, fi.Token(), "Attribute %s does not exist for object type %s", fi.Id(), ol.Type().Name())
+		}
+		if seen[fi.Id()] {
+			return nil, diagnostics.Errorf(ErrInvalidAttribute, fi.Token(), "Attribute %s is set more than once", fi.Id())
+		}
+		seen[fi.Id()] = true
+
+		t, err := typeCheckExpression(fi.Expression(), ctx, fe)
+		if err != nil {
+			return nil, err
+		}
+
+		tAtt := GetObjectAttributeType(fi.Id())
+		if !tAtt.Equal(t) && !IsPromotion(t, tAtt) {
+			return nil, diagnostics.Errorf(ErrTypeMismatch, fi.Expression().Token(), "Expression of type %s does not match type %s of attribute %s", t.Name(), tAtt.Name(), fi.Id())
+		}
+	}
+
+	return ol.Type(), nil
+}
+
+// typeCheckOperation returns the type of the result of a binary operation, the error is located at its right operand
+func typeCheckOperation(op string, prevType, nextType *types.Type, nextTok *token.Token, ctx *SemanticContext) (*types.Type, error) {
+	semCubeKey := GetSemanticCubeKey(op, []*types.Type{prevType, nextType})
+	basic, ok := ctx.SemCube().Get(semCubeKey)
+	if !ok {
+		return nil, diagnostics.Errorf(ErrInvalidOperation, nextTok, "Invalid operation %s %s %s", prevType.Name(), op, nextType.Name())
 	}
+
+	return types.NewDataType(basic, 0, 0), nil
 }
 
 func typeCheckExpression(expression *ast.Expression, ctx *SemanticContext, fe *directories.FuncEntry) (*types.Type, error) {
-	prevExp := expression.Exps()[0]
-	prevType, err := typeCheckExp(prevExp, ctx, fe)
+	prevType, err := typeCheckAndExp(expression.AndExps()[0], ctx, fe)
 	if err != nil {
 		return nil, err
 	}
-	
+
 	for i, op := range expression.Operations() {
-		nextExp := expression.Exps()[i+1]
-		nextType, err := typeCheckExp(nextExp, ctx, fe)
+		next := expression.AndExps()[i+1]
+		nextType, err := typeCheckAndExp(next, ctx, fe)
+		if err != nil {
+			return nil, err
+		}
+
+		prevType, err = typeCheckOperation(op, prevType, nextType, next.Token(), ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return prevType, nil
+}
+
+func typeCheckAndExp(and *ast.AndExp, ctx *SemanticContext, fe *directories.FuncEntry) (*types.Type, error) {
+	prevType, err := typeCheckEqualityExp(and.EqualityExps()[0], ctx, fe)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, op := range and.Operations() {
+		next := and.EqualityExps()[i+1]
+		nextType, err := typeCheckEqualityExp(next, ctx, fe)
 		if err != nil {
 			return nil, err
 		}
-		semCubeKey := GetSemanticCubeKey(op, []*types.Type{prevType, nextType})
-		basic, ok := ctx.SemCube().Get(semCubeKey)
-		if !ok {
-			return nil, errutil.Newf("%+v: Invalid operation %v %s %v", nextExp.Token(), prevType, op, nextType)
+
+		prevType, err = typeCheckOperation(op, prevType, nextType, next.Token(), ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return prevType, nil
+}
+
+func typeCheckEqualityExp(eq *ast.EqualityExp, ctx *SemanticContext, fe *directories.FuncEntry) (*types.Type, error) {
+	prevType, err := typeCheckRelationalExp(eq.RelationalExps()[0], ctx, fe)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, op := range eq.Operations() {
+		next := eq.RelationalExps()[i+1]
+		nextType, err := typeCheckRelationalExp(next, ctx, fe)
+		if err != nil {
+			return nil, err
+		}
+
+		prevType, err = typeCheckOperation(op, prevType, nextType, next.Token(), ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return prevType, nil
+}
+
+func typeCheckRelationalExp(rel *ast.RelationalExp, ctx *SemanticContext, fe *directories.FuncEntry) (*types.Type, error) {
+	prevType, err := typeCheckExp(rel.Exps()[0], ctx, fe)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, op := range rel.Operations() {
+		next := rel.Exps()[i+1]
+		nextType, err := typeCheckExp(next, ctx, fe)
+		if err != nil {
+			return nil, err
 		}
 
-		prevType = types.NewDataType(basic, 0, 0)
-		prevExp = nextExp
+		prevType, err = typeCheckOperation(op, prevType, nextType, next.Token(), ctx)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return prevType, nil
 }
 
 func typeCheckExp(exp *ast.Exp, ctx *SemanticContext, fe *directories.FuncEntry) (*types.Type, error) {
-	prevTerm := exp.Terms()[0]
-	prevType, err := typeCheckTerm(prevTerm, ctx, fe)
+	prevType, err := typeCheckTerm(exp.Terms()[0], ctx, fe)
 	if err != nil {
 		return nil, err
 	}
-	
+
 	for i, op := range exp.Operations() {
-		nextTerm := exp.Terms()[i+1]
-		nextType, err := typeCheckTerm(nextTerm, ctx, fe)
+		next := exp.Terms()[i+1]
+		nextType, err := typeCheckTerm(next, ctx, fe)
 		if err != nil {
 			return nil, err
 		}
-		semCubeKey := GetSemanticCubeKey(op, []*types.Type{prevType, nextType})
-		basic, ok := ctx.SemCube().Get(semCubeKey)
-		if !ok {
-			return nil, errutil.Newf("%+v: Invalid operation %v %s %v, key: %s", nextTerm.Token(), prevType, op, nextType, semCubeKey)
-		}
 
-		prevType = types.NewDataType(basic, 0, 0)
-		prevTerm = nextTerm
+		prevType, err = typeCheckOperation(op, prevType, nextType, next.Token(), ctx)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return prevType, nil
 }
 
 func typeCheckTerm(term *ast.Term, ctx *SemanticContext, fe *directories.FuncEntry) (*types.Type, error) {
-	prevFactor := term.Factors()[0]
-	prevType, err := typeCheckFactor(prevFactor, ctx, fe)
+	prevType, err := typeCheckFactor(term.Factors()[0], ctx, fe)
 	if err != nil {
 		return nil, err
 	}
-	
+
 	for i, op := range term.Operations() {
-		nextFactor := term.Factors()[i+1]
-		nextType, err := typeCheckFactor(nextFactor, ctx, fe)
+		next := term.Factors()[i+1]
+		nextType, err := typeCheckFactor(next, ctx, fe)
 		if err != nil {
 			return nil, err
 		}
-		semCubeKey := GetSemanticCubeKey(op, []*types.Type{prevType, nextType})
-		basic, ok := ctx.SemCube().Get(semCubeKey)
-		if !ok {
-			return nil, errutil.Newf("%+v: Invalid operation %v %s %v", prevType, op, nextType, nextFactor.Token())
-		}
 
-		prevType = types.NewDataType(basic, 0, 0)
-		prevFactor = nextFactor
+		prevType, err = typeCheckOperation(op, prevType, nextType, next.Token(), ctx)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return prevType, nil
@@ -326,18 +562,43 @@ func typeCheckFactor(factor *ast.Factor, ctx *SemanticContext, fe *directories.F
 		return typeCheckConstant(factor.Constant(), ctx, fe)
 	}
 
+	if factor.Unary() != nil {
+		return typeCheckUnary(factor.Unary(), ctx, fe)
+	}
+
 	return nil, errutil.Newf("Factor expression or constant not set %+v", factor.Token())
 }
 
+func typeCheckUnary(unary *ast.Unary, ctx *SemanticContext, fe *directories.FuncEntry) (*types.Type, error) {
+	t, err := typeCheckFactor(unary.Factor(), ctx, fe)
+	if err != nil {
+		return nil, err
+	}
+
+	semCubeKey := GetSemanticCubeKey(unary.Operation(), []*types.Type{t})
+	basic, ok := ctx.SemCube().Get(semCubeKey)
+	if !ok {
+		return nil, diagnostics.Errorf(ErrInvalidOperation, unary.Token(), "Invalid operation %s%s", unary.Operation(), t.Name())
+	}
+
+	return types.NewDataType(basic, 0, 0), nil
+}
+
 func typeCheckConstant(constant ast.Constant, ctx *SemanticContext, fe *directories.FuncEntry) (*types.Type, error) {
 	if cv, ok := constant.(*ast.ConstantValue); ok {
 		return cv.Type(), nil
 	} else if att, ok := constant.(*ast.Attribute); ok {
 		return typeCheckAttribute(att, ctx, fe)
+	} else if mc, ok := constant.(*ast.ClassFunctionCall); ok {
+		return typeCheckMethodCall(mc, ctx, fe)
 	} else if fc, ok := constant.(*ast.FunctionCall); ok {
 		return typeCheckFunctionCall(fc, ctx, fe)
 	} else if le, ok := constant.(*ast.ListElem); ok {
 		return typeCheckListElem(le, ctx, fe)
+	} else if c, ok := constant.(*ast.Cast); ok {
+		return typeCheckCast(c, ctx, fe)
+	} else if ol, ok := constant.(*ast.ObjectLiteral); ok {
+		return typeCheckObjectLiteral(ol, ctx, fe)
 	}
 
 	return nil, errutil.Newf("Cannot cast constant to any valid form %+v", constant.Token())
@@ -349,18 +610,31 @@ func typeCheckFunctionCall(fc *ast.FunctionCall, ctx *SemanticContext, fe *direc
 		if !IdIsReserved(fc.Id()) {
 			return nil, errutil.Newf("Cannot get function %s from FuncEntry", fc.Id())
 		}
+		if IsListFunction(fc.Id()) {
+			return typeCheckListFunction(fc, ctx, fe)
+		}
+		if IsStringFunction(fc.Id()) {
+			return typeCheckStringFunction(fc, ctx, fe)
+		}
 		t := GetReservedReturnType(fc.Id())
 		a := GetReservedParamAmount(fc.Id())
 
 		for _, param := range fc.Params() {
-			_, err := typeCheckExpression(param, ctx, fe)
+			pt, err := typeCheckExpression(param, ctx, fe)
 			if err != nil {
 				return nil, err
 			}
+			if pt.IsDynamic() {
+				return nil, diagnostics.Errorf(ErrArgumentType, param.Token(), "Cannot pass %s to %s", pt.Name(), fc.Id())
+			}
+			// A class is passed to the built-in functions as the object it embeds
+			if pt.IsStruct() && (pt.List() > 0 || pt.Struct().Embedded() == nil) {
+				return nil, diagnostics.Errorf(ErrArgumentType, param.Token(), "Cannot pass %s to %s, it does not embed an object", pt.Name(), fc.Id())
+			}
 		}
 
 		if len(fc.Params()) != a {
-			return nil, errutil.Newf("Reserved function %s requires %d args, %d given", fc.Id(), a, len(fc.Params()))
+			return nil, diagnostics.Errorf(ErrArgumentCount, fc.Token(), "Reserved function %s requires %d args, %d given", fc.Id(), a, len(fc.Params()))
 		}
 
 		return t, nil
@@ -378,28 +652,66 @@ func typeCheckFunctionCall(fc *ast.FunctionCall, ctx *SemanticContext, fe *direc
 	}
 
 	if len(argTypes) != len(currFe.Params()) {
-		return nil, errutil.Newf("Function %s expected %v arguments, got %v", fc.Id(), len(currFe.Params()), len(argTypes))
+		return nil, diagnostics.Errorf(ErrArgumentCount, fc.Token(), "Function %s expected %v arguments, got %v", fc.Id(), len(currFe.Params()), len(argTypes))
 	}
 
 	for i := range argTypes {
-		if !argTypes[i].Equal(currFe.Params()[i]) {
-			return nil, errutil.Newf("In function call %s, expected type %v for position %v, got %v", fc.Id(), currFe.Params()[i], i+1, argTypes[i])
+		if err := typeCheckLossyConversion(argTypes[i], currFe.Params()[i], fc.Params()[i].Token(), "function call "+fc.Id()); err != nil {
+			return nil, err
+		}
+		if IsPromotion(argTypes[i], currFe.Params()[i]) {
+			continue
+		}
+		if !argTypes[i].Equal(currFe.Params()[i]) || !argTypes[i].EqualDims(currFe.Params()[i]) {
+			return nil, diagnostics.Errorf(ErrArgumentType, fc.Params()[i].Token(), "In function call %s, expected type %s for position %v, got %s", fc.Id(), currFe.Params()[i].Name(), i+1, argTypes[i].Name())
 		}
 	}
 
 	return currFe.ReturnType(), nil
 }
 
-func typeCheckListElem(le *ast.ListElem, ctx *SemanticContext, fe *directories.FuncEntry) (*types.Type, error) {
-	t, err := typeCheckExpression(le.Index(), ctx, fe)
-	if err != nil {
-		return nil, err
+// typeCheckMethodCall checks the arguments of a method call, the first parameter of the method is the object
+func typeCheckMethodCall(mc *ast.ClassFunctionCall, ctx *SemanticContext, fe *directories.FuncEntry) (*types.Type, error) {
+	ve := fe.VarDir().Get(mc.Obj())
+	if ve == nil {
+		ve = ctx.Globals().Get(mc.Obj())
+	}
+	if ve == nil {
+		return nil, errutil.Newf("Cannot find %s in local or global scope", mc.Obj())
 	}
 
-	if t.Basic() != types.Int {
-		return nil, errutil.Newf("%+v: Index for array must be type integer", le.Token())
+	class := ve.Type().Struct().Name()
+	currFe := ctx.FuncDir().Get(methodKey(class, mc.Id()))
+	if currFe == nil {
+		return nil, errutil.Newf("Cannot get method %s of %s from FuncEntry", mc.Id(), class)
 	}
 
+	params := currFe.Params()[1:]
+	if len(mc.Params()) != len(params) {
+		return nil, diagnostics.Errorf(ErrArgumentCount, mc.Token(), "Method %s of %s expected %v arguments, got %v", mc.Id(), class, len(params), len(mc.Params()))
+	}
+
+	for i, param := range mc.Params() {
+		t, err := typeCheckExpression(param, ctx, fe)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := typeCheckLossyConversion(t, params[i], param.Token(), "method call "+mc.Id()); err != nil {
+			return nil, err
+		}
+		if IsPromotion(t, params[i]) {
+			continue
+		}
+		if !t.Equal(params[i]) || !t.EqualDims(params[i]) {
+			return nil, diagnostics.Errorf(ErrArgumentType, param.Token(), "In method call %s, expected type %s for position %v, got %s", mc.Id(), params[i].Name(), i+1, t.Name())
+		}
+	}
+
+	return currFe.ReturnType(), nil
+}
+
+func typeCheckListElem(le *ast.ListElem, ctx *SemanticContext, fe *directories.FuncEntry) (*types.Type, error) {
 	ve := fe.VarDir().Get(le.Id())
 	if ve == nil {
 		ve = ctx.Globals().Get(le.Id())
@@ -408,8 +720,49 @@ func typeCheckListElem(le *ast.ListElem, ctx *SemanticContext, fe *directories.F
 		return nil, errutil.Newf("Cannot find %s in local or global scope", le.Id())
 	}
 
-	nt := ve.Type().Copy()
-	nt.DecreaseList()
+	return typeCheckIndexes(le.Indexes(), ve, le.Token(), ctx, fe)
+}
+
+//typeCheckIndexes checks that an array is indexed with an integer for every one of its dimensions,
+// a list with a single one and a string too. The result is the type of the elements
+func typeCheckIndexes(indexes []*ast.Expression, ve *directories.VarEntry, tok *token.Token, ctx *SemanticContext, fe *directories.FuncEntry) (*types.Type, error) {
+	if ve.Type().IsDynamic() && len(indexes) != 1 {
+		return nil, diagnostics.Errorf(ErrInvalidIndex, tok, "List %s has 1 dimension, got %d indexes", ve.Id(), len(indexes))
+	}
+
+	// Indexing a string gives one of its characters
+	if isString(ve.Type()) {
+		if len(indexes) != 1 {
+			return nil, diagnostics.Errorf(ErrInvalidIndex, tok, "String %s has 1 dimension, got %d indexes", ve.Id(), len(indexes))
+		}
+		t, err := typeCheckExpression(indexes[0], ctx, fe)
+		if err != nil {
+			return nil, err
+		}
+		if !t.Equal(types.NewDataType(types.Int, 0, 0)) {
+			return nil, diagnostics.Errorf(ErrInvalidIndex, indexes[0].Token(), "Index for string must be type integer")
+		}
+		return types.NewDataType(types.Char, 0, 0), nil
+	}
+
+	if ve.Type().List() < 1 && !ve.Type().IsDynamic() {
+		return nil, diagnostics.Errorf(ErrInvalidIndex, tok, "Variable %s is not array, cannot index", ve.Id())
+	}
+
+	if len(indexes) != ve.Type().List() && !ve.Type().IsDynamic() {
+		return nil, diagnostics.Errorf(ErrInvalidIndex, tok, "Array %s has %d dimensions, got %d indexes", ve.Id(), ve.Type().List(), len(indexes))
+	}
+
+	for _, index := range indexes {
+		t, err := typeCheckExpression(index, ctx, fe)
+		if err != nil {
+			return nil, err
+		}
+
+		if !t.Equal(types.NewDataType(types.Int, 0, 0)) {
+			return nil, diagnostics.Errorf(ErrInvalidIndex, index.Token(), "Index for array must be type integer")
+		}
+	}
 
-	return nt, nil
+	return ve.Type().Elem(), nil
 }
\ No newline at end of file
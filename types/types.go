@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"strings"
 )
 
@@ -115,7 +116,9 @@ type Type struct {
 	object		ObjType
 	list    	int
 	isObject	bool
-	size 		int
+	dims 		[]int
+	structure	*Struct
+	dynamic		bool
 }
 
 // String converts the type to its string representation which is used only in the dirfunc package
@@ -126,9 +129,15 @@ func (l Type) String() string {
 	for i := 0; i < l.list; i++ {
 		builder.WriteRune('[')
 	}
+	if l.dynamic {
+		builder.WriteRune(ListCode)
+	}
 	
 	
-	if l.isObject {
+	if l.structure != nil {
+		builder.WriteRune(StructCode)
+		builder.WriteString(l.structure.name)
+	} else if l.isObject {
 		builder.WriteRune(l.object.convert())
 
 	} else {
@@ -142,6 +151,32 @@ func (l Type) String() string {
 	return builder.String()
 }
 
+// basicNames and objectNames are the keywords of the types in the source
+var basicNames = map[BasicType]string{Int: "int", Float: "float", Char: "char", Bool: "bool", Void: "void", String: "string"}
+var objectNames = map[ObjType]string{Square: "Square", Circle: "Circle", Image: "Image", Text: "Text", Background: "Background"}
+
+// Name converts the type to the way it is written in the source, like int or Square[10]
+func (lt *Type) Name() string {
+	name := basicNames[lt.basic]
+	if lt.isObject {
+		name = objectNames[lt.object]
+	}
+	if lt.structure != nil {
+		name = lt.structure.name
+	}
+	if name == "" {
+		name = "unknown"
+	}
+	if lt.dynamic {
+		name = "list<" + name + ">"
+	}
+
+	for _, d := range lt.dims {
+		name += fmt.Sprintf("[%d]", d)
+	}
+	return name
+}
+
 // List
 func (lt *Type) List() int {
 	return lt.list
@@ -162,19 +197,71 @@ func (lt *Type) IsObject() bool {
 	return lt.isObject
 }
 
-// Size
+// Struct
+func (lt *Type) Struct() *Struct {
+	return lt.structure
+}
+
+// IsStruct
+func (lt *Type) IsStruct() bool {
+	return lt.structure != nil
+}
+
+// IsDynamic tells if the type is a list<T>, which grows at runtime instead of having a size
+func (lt *Type) IsDynamic() bool {
+	return lt.dynamic
+}
+
+// SetStruct replaces the struct of the type with the declared one, which has its fields
+func (lt *Type) SetStruct(s *Struct) {
+	lt.structure = s
+}
+
+// Size is the size of the first dimension of an array
 func (lt *Type) Size() int {
-	return lt.size
+	if len(lt.dims) == 0 {
+		return 0
+	}
+	return lt.dims[0]
 }
 
-// List
+// Dims are the sizes of every dimension of an array, from the outermost one
+func (lt *Type) Dims() []int {
+	return lt.dims
+}
+
+// Elements is the amount of elements of an array, counting every dimension
+func (lt *Type) Elements() int {
+	elements := 1
+	for _, d := range lt.dims {
+		elements *= d
+	}
+	return elements
+}
+
+// AddDimension adds an inner dimension to the type, int[20] becomes int[20][size]
+func (lt *Type) AddDimension(size int) {
+	lt.list = lt.list + 1
+	lt.dims = append(lt.dims, size)
+}
+
+// Elem is the type of the elements of an array or a list, without any of its dimensions
+func (lt *Type) Elem() *Type {
+	return &Type{lt.basic, lt.object, 0, lt.isObject, nil, lt.structure, false}
+}
+
+// DecreaseList removes the outermost dimension, which is the type of the result of indexing it
 func (lt *Type) DecreaseList() {
 	lt.list = lt.list - 1
+	if len(lt.dims) > 0 {
+		lt.dims = lt.dims[1:]
+	}
 }
 
 // List
 func (lt *Type) IncreaseList() {
 	lt.list = lt.list + 1
+	lt.dims = append([]int{0}, lt.dims...)
 }
 
 //Equal
@@ -182,18 +269,162 @@ func (lt *Type) Equal(lt2 *Type) bool {
 	return lt.String() == lt2.String()
 }
 
+// EqualDims compares the size of every dimension, an argument must have the shape of the parameter
+func (lt *Type) EqualDims(lt2 *Type) bool {
+	if len(lt.dims) != len(lt2.dims) {
+		return false
+	}
+	for i := range lt.dims {
+		if lt.dims[i] != lt2.dims[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (lt *Type) Copy() *Type {
-	return &Type{lt.basic, lt.object, lt.list, lt.isObject, lt.size}
+	dims := make([]int, len(lt.dims))
+	copy(dims, lt.dims)
+	return &Type{lt.basic, lt.object, lt.list, lt.isObject, dims, lt.structure, lt.dynamic}
 }
 
 // NewDataType Declares a new, basic  type
 func NewDataType(b BasicType, list int, size int) *Type {
-	return &Type{b, NullObj, list, false, size}
+	return &Type{b, NullObj, list, false, newDims(list, size), nil, false}
 }
 
 // NewDataType Declares a new, object  type
 func NewObjectType(o ObjType, list int, size int) *Type {
-	return &Type{Null, o, list, true, size}
+	return &Type{Null, o, list, true, newDims(list, size), nil, false}
+}
+
+// NewStructType Declares a new type of a struct declared in the program
+func NewStructType(s *Struct, list int, size int) *Type {
+	return &Type{Null, NullObj, list, false, newDims(list, size), s, false}
+}
+
+// NewListType Declares a list whose elements are of the given type
+func NewListType(elem *Type) *Type {
+	return &Type{elem.basic, elem.object, 0, elem.isObject, nil, elem.structure, true}
+}
+
+// newDims creates the dimensions of a type, size is the one of the outermost dimension
+func newDims(list int, size int) []int {
+	if list == 0 {
+		return nil
+	}
+	dims := make([]int, list)
+	dims[0] = size
+	return dims
+}
+
+// StructCode is the code of the struct types, it is followed by the name of the struct
+const StructCode = 'c'
+
+// StructInitCode is the type given to the Init quad of the header of a struct
+const StructInitCode = 12
+
+// ListCode is the code of the list types, it is followed by the code of their elements
+const ListCode = 'l'
+
+// ListInitCode is the type given to the Init quad of a list, which creates an empty one
+const ListInitCode = 13
+
+// Field is a member of a struct, stored at an offset from the start of the struct
+type Field struct {
+	name   string
+	t      *Type
+	offset int
+}
+
+// Name
+func (f *Field) Name() string {
+	return f.name
+}
+
+// Type
+func (f *Field) Type() *Type {
+	return f.t
+}
+
+// Offset
+func (f *Field) Offset() int {
+	return f.offset
+}
+
+// Struct is the layout of a struct declared in the program. The first cell of a struct is its header
+// and every field takes one of the following cells
+type Struct struct {
+	name     string
+	fields   []*Field
+	embedded *Field
+	class    bool
+}
+
+// Name
+func (s *Struct) Name() string {
+	return s.name
+}
+
+// Fields
+func (s *Struct) Fields() []*Field {
+	return s.fields
+}
+
+// Field returns the field with the name, or nil if the struct does not have it
+func (s *Struct) Field(name string) *Field {
+	for _, f := range s.fields {
+		if f.name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// AddField appends a field to the struct, it returns false if the struct already has one with that name
+func (s *Struct) AddField(name string, t *Type) bool {
+	if s.Field(name) != nil {
+		return false
+	}
+
+	s.fields = append(s.fields, &Field{name, t, len(s.fields) + 1})
+	return true
+}
+
+// Embed adds an object as a field named as its type, which lets a class be used where the object is expected.
+// It must be added before any other field
+func (s *Struct) Embed(o ObjType) {
+	t := NewObjectType(o, 0, 0)
+	s.embedded = &Field{t.Name(), t, len(s.fields) + 1}
+	s.fields = append(s.fields, s.embedded)
+}
+
+// SetClass marks the struct as declared with class, which can have methods
+func (s *Struct) SetClass() {
+	s.class = true
+}
+
+// Kind is the keyword of the declaration of the struct, struct or class
+func (s *Struct) Kind() string {
+	if s.class {
+		return "class"
+	}
+	return "struct"
+}
+
+// Embedded returns the field of the embedded object, or nil if the struct does not embed one
+func (s *Struct) Embedded() *Field {
+	return s.embedded
+}
+
+// Size is the amount of cells of the struct, counting the header
+func (s *Struct) Size() int {
+	return len(s.fields) + 1
+}
+
+// NewStruct creates a struct without fields
+func NewStruct(name string) *Struct {
+	return &Struct{name, make([]*Field, 0), nil, false}
 }
 
 var ObjectAttributesIndex = map[string]int{
@@ -135,6 +135,34 @@ func (bp BackgroundParam) Addr() mem.Address {
 	return bp.addr
 }
 
+// StructParam is a cell of a struct, either its header or one of its fields
+type StructParam struct {
+	value interface{}
+	addr  mem.Address
+}
+
+func (sp StructParam) Value() interface{} {
+	return sp.value
+}
+
+func (sp StructParam) Addr() mem.Address {
+	return sp.addr
+}
+
+// ListParam is the cell of a list, which references its elements in the heap
+type ListParam struct {
+	value *objects.List
+	addr  mem.Address
+}
+
+func (lp ListParam) Value() *objects.List {
+	return lp.value
+}
+
+func (lp ListParam) Addr() mem.Address {
+	return lp.addr
+}
+
 type ActivationRecord struct {
 	retip      			int
 	floatparams  		[]FloatParam
@@ -147,6 +175,8 @@ type ActivationRecord struct {
 	imageparams			[]ImageParam
 	textparams			[]TextParam
 	backgroundparams	[]BackgroundParam
+	structparams		[]StructParam
+	listparams			[]ListParam
 	floattemps   		[]FloatParam
 	inttemps   			[]IntParam
 	chartemps  			[]CharParam
@@ -157,6 +187,8 @@ type ActivationRecord struct {
 	imagetemps			[]ImageParam
 	texttemps			[]TextParam
 	backgroundtemps		[]BackgroundParam
+	structtemps			[]StructParam
+	listtemps			[]ListParam
 	floatcount   		int
 	intcount			int
 	charcount  			int
@@ -167,6 +199,9 @@ type ActivationRecord struct {
 	imagecount			int
 	textcount			int
 	backgroundcount		int
+	structcount			int
+	listcount			int
+	this				mem.Address
 }
 
 func (a *ActivationRecord) SetRetIp(ip int) {
@@ -233,6 +268,20 @@ func (a *ActivationRecord) AddBackgroundParam(b objects.Background) {
 	a.backgroundcount++
 }
 
+// AddStructParam adds the header and the fields of a struct, which take the next cells of the struct segment
+func (a *ActivationRecord) AddStructParam(s objects.Struct) {
+	addr := mem.Address(a.structcount + mem.Localstart + mem.StructOffset)
+	a.structparams = append(a.structparams, StructParam{s, addr})
+	a.structcount += len(s.Fields()) + 1
+}
+
+// AddListParam passes the reference to a list, the function changes the same list as the caller
+func (a *ActivationRecord) AddListParam(l *objects.List) {
+	addr := mem.Address(a.listcount + mem.Localstart + mem.ListOffset)
+	a.listparams = append(a.listparams, ListParam{l, addr})
+	a.listcount++
+}
+
 func (a *ActivationRecord) AddFloatLocal(f float64, addr mem.Address) {
 	a.floatparams = append(a.floatparams, FloatParam{f, addr})
 	a.floatcount++
@@ -283,6 +332,16 @@ func (a *ActivationRecord) AddBackgroundLocal(b objects.Background, addr mem.Add
 	a.backgroundcount++
 }
 
+func (a *ActivationRecord) AddStructLocal(cell interface{}, addr mem.Address) {
+	a.structparams = append(a.structparams, StructParam{cell, addr})
+	a.structcount++
+}
+
+func (a *ActivationRecord) AddListLocal(l *objects.List, addr mem.Address) {
+	a.listparams = append(a.listparams, ListParam{l, addr})
+	a.listcount++
+}
+
 func (a *ActivationRecord) AddFloatTemp(f float64, addr mem.Address) {
 	a.floattemps = append(a.floattemps, FloatParam{f, addr})
 }
@@ -323,6 +382,14 @@ func (a *ActivationRecord) AddBackgroundTemp(b objects.Background, addr mem.Addr
 	a.backgroundtemps = append(a.backgroundtemps, BackgroundParam{b, addr})
 }
 
+func (a *ActivationRecord) AddStructTemp(cell interface{}, addr mem.Address) {
+	a.structtemps = append(a.structtemps, StructParam{cell, addr})
+}
+
+func (a *ActivationRecord) AddListTemp(l *objects.List, addr mem.Address) {
+	a.listtemps = append(a.listtemps, ListParam{l, addr})
+}
+
 func (a *ActivationRecord) ResetTemps() {
 	a.floattemps = make([]FloatParam, 0)
 	a.inttemps = make([]IntParam, 0)
@@ -334,6 +401,8 @@ func (a *ActivationRecord) ResetTemps() {
 	a.imagetemps = make([]ImageParam, 0)
 	a.texttemps = make([]TextParam, 0)
 	a.backgroundtemps = make([]BackgroundParam, 0)
+	a.structtemps = make([]StructParam, 0)
+	a.listtemps = make([]ListParam, 0)
 }
 
 func (a *ActivationRecord) ResetParams() {
@@ -347,6 +416,8 @@ func (a *ActivationRecord) ResetParams() {
 	a.imageparams = make([]ImageParam, 0)
 	a.textparams = make([]TextParam, 0)
 	a.backgroundparams = make([]BackgroundParam, 0)
+	a.structparams = make([]StructParam, 0)
+	a.listparams = make([]ListParam, 0)
 }
 
 func NewActivationRecord() *ActivationRecord {
@@ -362,6 +433,8 @@ func NewActivationRecord() *ActivationRecord {
 		make([]ImageParam, 0),				// imageparams
 		make([]TextParam, 0),				// textparams
 		make([]BackgroundParam, 0),			// backgroundparams
+		make([]StructParam, 0),				// structparams
+		make([]ListParam, 0),				// listparams
 		make([]FloatParam, 0),				// floattemps
 		make([]IntParam, 0),				// inttemps
 		make([]CharParam, 0),				// chartemps
@@ -372,6 +445,8 @@ func NewActivationRecord() *ActivationRecord {
 		make([]ImageParam, 0),				// imagetemps
 		make([]TextParam, 0),				// textparams
 		make([]BackgroundParam, 0),			// backgroundtemps
+		make([]StructParam, 0),				// structtemps
+		make([]ListParam, 0),				// listtemps
 		0,									// floatcount
 		0,									// intcount
 		0,									// charcount
@@ -382,6 +457,9 @@ func NewActivationRecord() *ActivationRecord {
 		0,									// imagecount
 		0,									// textcount
 		0,									// backgroundcount
+		0,									// structcount
+		0,									// listcount
+		mem.Address(-1),					// this
 	}
 }
 
@@ -475,6 +553,24 @@ func (a *ActivationRecord) SetBackgroundcount(backgroundcount int) {
 	a.backgroundcount = backgroundcount
 }
 
+// structcount getter and setter
+func (a *ActivationRecord) Structcount() int {
+	return a.structcount
+}
+
+func (a *ActivationRecord) SetStructcount(structcount int) {
+	a.structcount = structcount
+}
+
+// listcount getter and setter
+func (a *ActivationRecord) Listcount() int {
+	return a.listcount
+}
+
+func (a *ActivationRecord) SetListcount(listcount int) {
+	a.listcount = listcount
+}
+
 // stringtemps getter and setter
 func (a *ActivationRecord) Stringtemps() []StringParam {
 	return a.stringtemps
@@ -565,6 +661,24 @@ func (a *ActivationRecord) SetBackgroundtemps(backgroundtemps []BackgroundParam)
 	a.backgroundtemps = backgroundtemps
 }
 
+// structtemps getter and setter
+func (a *ActivationRecord) Structtemps() []StructParam {
+	return a.structtemps
+}
+
+func (a *ActivationRecord) SetStructtemps(structtemps []StructParam) {
+	a.structtemps = structtemps
+}
+
+// listtemps getter and setter
+func (a *ActivationRecord) Listtemps() []ListParam {
+	return a.listtemps
+}
+
+func (a *ActivationRecord) SetListtemps(listtemps []ListParam) {
+	a.listtemps = listtemps
+}
+
 // stringparams getter and setter
 func (a *ActivationRecord) Stringparams() []StringParam {
 	return a.stringparams
@@ -655,6 +769,33 @@ func (a *ActivationRecord) SetBackgroundparams(backgroundparams []BackgroundPara
 	a.backgroundparams = backgroundparams
 }
 
+// structparams getter and setter
+func (a *ActivationRecord) Structparams() []StructParam {
+	return a.structparams
+}
+
+func (a *ActivationRecord) SetStructparams(structparams []StructParam) {
+	a.structparams = structparams
+}
+
+// listparams getter and setter
+func (a *ActivationRecord) Listparams() []ListParam {
+	return a.listparams
+}
+
+func (a *ActivationRecord) SetListparams(listparams []ListParam) {
+	a.listparams = listparams
+}
+
+// This is the address of the object of a method call in the caller, it is -1 for functions
+func (a *ActivationRecord) This() mem.Address {
+	return a.this
+}
+
+func (a *ActivationRecord) SetThis(addr mem.Address) {
+	a.this = addr
+}
+
 // retip getter and setter
 func (a *ActivationRecord) Retip() int {
 	return a.retip
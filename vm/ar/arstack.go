@@ -37,6 +37,15 @@ func (s *ArStack) Push(val *ActivationRecord) {
 	s.head = newHead
 }
 
+// Records returns every element in the container, starting with the first one
+func (s *ArStack) Records() []*ActivationRecord {
+	records := make([]*ActivationRecord, 0)
+	for n := s.head; n != nil; n = n.next {
+		records = append(records, n.val)
+	}
+	return records
+}
+
 func NewArStack() *ArStack {
 	return &ArStack{nil}
 }
\ No newline at end of file
@@ -0,0 +1,384 @@
+package vm
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sdkvictor/golang-compiler/bytecode"
+	"github.com/sdkvictor/golang-compiler/engine"
+	"github.com/sdkvictor/golang-compiler/mem"
+	"github.com/sdkvictor/golang-compiler/objects"
+	"github.com/mewkiz/pkg/errutil"
+)
+
+const debuggerHelp = `Commands:
+  break <line|function>   stop before the line or the first line of the function, b for short
+  delete <line|function>  remove a breakpoint
+  breakpoints             list the breakpoints
+  step                    run until the next line, entering function calls, s for short
+  next                    run until the next line of the current function, n for short
+  continue                run until a breakpoint or the end of the program, c for short
+  backtrace               print the function of every activation record, bt for short
+  print <variable>        print a local or global variable, p for short
+  locals                  print the variables of the current function
+  globals                 print the global variables
+  quit                    stop debugging, q for short
+An empty line repeats the last command
+`
+
+// Debugger executes a program step by step, stopping at breakpoints to inspect the activation records
+// and the variables with the symbol table of the program
+type Debugger struct {
+	vm          *VirtualMachine
+	prog        *bytecode.Program
+	source      []string
+	in          *bufio.Scanner
+	out         io.Writer
+	breakpoints map[int]string
+	last        string
+}
+
+// line returns the source line of a quad, 0 if it does not have one
+func (d *Debugger) line(ip int) int {
+	return d.vm.position(ip).Line
+}
+
+// location describes the quad where the program is stopped
+func (d *Debugger) location() string {
+	frames := d.vm.callStack()
+	if len(frames) == 0 {
+		return fmt.Sprintf("quad %d", d.vm.ip)
+	}
+
+	line := d.line(d.vm.ip)
+	if line == 0 {
+		return fmt.Sprintf("%s, quad %d", frames[0].function, d.vm.ip)
+	}
+
+	loc := fmt.Sprintf("%s, line %d", frames[0].function, line)
+	if line <= len(d.source) {
+		loc += fmt.Sprintf("\n%5d  %s", line, strings.TrimSpace(d.source[line-1]))
+	}
+	return loc
+}
+
+// resume executes quads until the program ends, a breakpoint is reached or stop returns true.
+// Quads without a source line never stop the execution
+func (d *Debugger) resume(stop func() bool) error {
+	for {
+		if err := d.vm.executeNextInstruction(); err != nil {
+			return d.vm.runtimeError(err)
+		}
+
+		if d.vm.finished() {
+			fmt.Fprintf(d.out, "Program finished\n")
+			return nil
+		}
+
+		if bp, ok := d.breakpoints[d.vm.ip]; ok {
+			fmt.Fprintf(d.out, "Breakpoint %s: %s\n", bp, d.location())
+			return nil
+		}
+
+		if d.line(d.vm.ip) != 0 && stop() {
+			fmt.Fprintf(d.out, "Stopped in %s\n", d.location())
+			return nil
+		}
+	}
+}
+
+// step stops at the next line, which can be in a function that is called
+func (d *Debugger) step() error {
+	line, depth := d.line(d.vm.ip), len(d.vm.ar.Records())
+	return d.resume(func() bool {
+		return d.line(d.vm.ip) != line || len(d.vm.ar.Records()) != depth
+	})
+}
+
+// next stops at the next line of the current function, or of its caller once it returns
+func (d *Debugger) next() error {
+	line, depth := d.line(d.vm.ip), len(d.vm.ar.Records())
+	return d.resume(func() bool {
+		current := len(d.vm.ar.Records())
+		return current < depth || (current == depth && d.line(d.vm.ip) != line)
+	})
+}
+
+// breakpointQuads finds the quads where a breakpoint stops, which are the start of every sequence of
+// quads of a line or the location of a function
+func (d *Debugger) breakpointQuads(target string) ([]int, error) {
+	quads := make([]int, 0)
+
+	line, err := strconv.Atoi(target)
+	if err != nil {
+		for loc, name := range d.vm.functions {
+			if name == target {
+				quads = append(quads, int(loc))
+			}
+		}
+
+		if len(quads) == 0 {
+			return nil, errutil.NewNoPosf("Function %s does not exist", target)
+		}
+		return quads, nil
+	}
+
+	for i := range d.vm.quads {
+		if d.line(i) == line && d.line(i-1) != line {
+			quads = append(quads, i)
+		}
+	}
+
+	if len(quads) == 0 {
+		return nil, errutil.NewNoPosf("Line %d has no code", line)
+	}
+	return quads, nil
+}
+
+// lookup finds a variable of the current function, or a global one if the function has none with that name
+func (d *Debugger) lookup(name string) (bytecode.Symbol, bool) {
+	function := ""
+	if frames := d.vm.callStack(); len(frames) > 0 {
+		function = frames[0].function
+	}
+
+	global, found := bytecode.Symbol{}, false
+	for _, sym := range d.prog.Symbols {
+		if sym.Name != name {
+			continue
+		}
+		if sym.Function == function {
+			return sym, true
+		}
+		if sym.Function == "" {
+			global, found = sym, true
+		}
+	}
+
+	return global, found
+}
+
+// value reads a variable from memory, every element of it if it is a list
+func (d *Debugger) value(sym bytecode.Symbol) string {
+	if sym.Size == 0 {
+		v, err := d.vm.mm.GetValue(sym.Addr)
+		if err != nil {
+			return "<not initialized>"
+		}
+		return formatValue(v)
+	}
+
+	elems := make([]string, 0, sym.Size)
+	stride := 0
+	for i := 0; i < sym.Size; i++ {
+		offset, err := getOffsetObject(i, sym.Addr)
+		if err != nil {
+			return "<not initialized>"
+		}
+
+		// The elements of an array of structs are as far apart as the cells of the first one
+		if stride > 0 {
+			offset = i * stride
+		}
+
+		v, err := d.vm.mm.GetValue(sym.Addr + mem.Address(offset))
+		if err != nil {
+			return "<not initialized>"
+		}
+		if st, ok := v.(objects.Struct); ok {
+			stride = len(st.Fields()) + 1
+		}
+		elems = append(elems, formatValue(v))
+	}
+
+	return "[" + strings.Join(elems, ", ") + "]"
+}
+
+// printSymbols prints every variable of a function, or the global ones if function is empty
+func (d *Debugger) printSymbols(function string) {
+	found := false
+	for _, sym := range d.prog.Symbols {
+		if sym.Function == function {
+			fmt.Fprintf(d.out, "%s = %s\n", sym.Name, d.value(sym))
+			found = true
+		}
+	}
+
+	if !found {
+		fmt.Fprintf(d.out, "No variables\n")
+	}
+}
+
+// execute runs a command, it returns true once debugging should stop
+func (d *Debugger) execute(command string, args []string) (bool, error) {
+	needsArg := func() bool {
+		if len(args) != 1 {
+			fmt.Fprintf(d.out, "%s expects 1 argument\n", command)
+			return false
+		}
+		return true
+	}
+
+	switch command {
+	case "break", "b":
+		if !needsArg() {
+			return false, nil
+		}
+
+		quads, err := d.breakpointQuads(args[0])
+		if err != nil {
+			fmt.Fprintf(d.out, "%v\n", err)
+			return false, nil
+		}
+
+		for _, q := range quads {
+			d.breakpoints[q] = args[0]
+		}
+		fmt.Fprintf(d.out, "Breakpoint %s set\n", args[0])
+	case "delete", "d":
+		if !needsArg() {
+			return false, nil
+		}
+
+		deleted := false
+		for q, bp := range d.breakpoints {
+			if bp == args[0] {
+				delete(d.breakpoints, q)
+				deleted = true
+			}
+		}
+
+		if !deleted {
+			fmt.Fprintf(d.out, "No breakpoint %s\n", args[0])
+		}
+	case "breakpoints":
+		names := make([]string, 0)
+		seen := make(map[string]bool)
+		for _, bp := range d.breakpoints {
+			if !seen[bp] {
+				names = append(names, bp)
+				seen[bp] = true
+			}
+		}
+
+		sort.Strings(names)
+		if len(names) == 0 {
+			fmt.Fprintf(d.out, "No breakpoints\n")
+		}
+		for _, name := range names {
+			fmt.Fprintf(d.out, "Breakpoint %s\n", name)
+		}
+	case "step", "s":
+		return false, d.step()
+	case "next", "n":
+		return false, d.next()
+	case "continue", "c":
+		return false, d.resume(func() bool { return false })
+	case "backtrace", "bt":
+		for i, f := range d.vm.callStack() {
+			if line := d.line(f.ip); line != 0 {
+				fmt.Fprintf(d.out, "#%d  %s, line %d\n", i, f.function, line)
+			} else {
+				fmt.Fprintf(d.out, "#%d  %s, quad %d\n", i, f.function, f.ip)
+			}
+		}
+	case "print", "p":
+		if !needsArg() {
+			return false, nil
+		}
+
+		sym, ok := d.lookup(args[0])
+		if !ok {
+			fmt.Fprintf(d.out, "Variable %s does not exist\n", args[0])
+			return false, nil
+		}
+		fmt.Fprintf(d.out, "%s = %s\n", sym.Name, d.value(sym))
+	case "locals":
+		if frames := d.vm.callStack(); len(frames) > 0 {
+			d.printSymbols(frames[0].function)
+		}
+	case "globals":
+		d.printSymbols("")
+	case "quit", "q":
+		return true, nil
+	case "help", "h":
+		fmt.Fprint(d.out, debuggerHelp)
+	default:
+		fmt.Fprintf(d.out, "Unknown command %s, type help to list the commands\n", command)
+	}
+
+	return false, nil
+}
+
+// Run stops before the first line of the program and then executes the commands read from the input
+// until the program finishes or the input ends
+func (d *Debugger) Run() error {
+	if len(d.vm.quads) < 1 {
+		return errutil.NewNoPosf("No instructions to execute")
+	}
+
+	if d.prog.Symbols == nil {
+		fmt.Fprintf(d.out, "The program has no symbol table, variables cannot be printed\n")
+	}
+
+	d.vm.start()
+
+	// The quads before main, like the initialization of the globals, do not have a line
+	if d.line(d.vm.ip) == 0 {
+		if err := d.resume(func() bool { return true }); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprintf(d.out, "Stopped in %s\n", d.location())
+	}
+
+	for !d.vm.finished() {
+		fmt.Fprintf(d.out, "(debug) ")
+		if !d.in.Scan() {
+			fmt.Fprintf(d.out, "\n")
+			return d.in.Err()
+		}
+
+		text := strings.TrimSpace(d.in.Text())
+		if text == "" {
+			text = d.last
+		}
+		d.last = text
+
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		quit, err := d.execute(fields[0], fields[1:])
+		if err != nil {
+			return err
+		}
+		if quit {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// NewDebugger creates a debugger for the program, the source is only used to show the lines where
+// it stops and can be nil
+func NewDebugger(prog *bytecode.Program, source []byte, e engine.Engine, in io.Reader, out io.Writer) (*Debugger, error) {
+	mach := NewVirtualMachine(prog.Quads, e)
+	if err := mach.LoadConstantPool(prog.Constants); err != nil {
+		return nil, err
+	}
+	mach.LoadDebugInfo(prog)
+
+	lines := make([]string, 0)
+	if source != nil {
+		lines = strings.Split(string(source), "\n")
+	}
+
+	return &Debugger{mach, prog, lines, bufio.NewScanner(in), out, make(map[int]string), ""}, nil
+}
@@ -0,0 +1,129 @@
+package vm
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/sdkvictor/golang-compiler/bytecode"
+	"github.com/sdkvictor/golang-compiler/mem"
+	"github.com/sdkvictor/golang-compiler/quad"
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// parseQuadruple rebuilds a quadruple from a line of an object file
+func parseQuadruple(line string, n int) (*quad.Quadruple, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 4 {
+		return nil, errutil.NewNoPosf("Line %d: Expected an operation and 3 addresses, got %q", n, line)
+	}
+
+	op := quad.StringToOperation(fields[0])
+	if op == quad.Invalid {
+		return nil, errutil.NewNoPosf("Line %d: Invalid operation %s", n, fields[0])
+	}
+
+	addrs := make([]mem.Address, 3)
+	for i, field := range fields[1:] {
+		a, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, errutil.NewNoPosf("Line %d: Invalid address %s", n, field)
+		}
+		addrs[i] = mem.Address(a)
+	}
+
+	return quad.NewQuadruple(op, addrs[0], addrs[1], addrs[2]), nil
+}
+
+// parseCount reads the amount of quads or constants in a line of an object file
+func parseCount(lines []string, n int) (int, error) {
+	if n >= len(lines) {
+		return 0, errutil.NewNoPosf("Line %d: Unexpected end of file", n+1)
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(lines[n]))
+	if err != nil || count < 0 {
+		return 0, errutil.NewNoPosf("Line %d: Invalid count %q", n+1, lines[n])
+	}
+
+	return count, nil
+}
+
+// ParseObject rebuilds the quadruples and the constant map written by ic.Generator.CreateFile
+func ParseObject(src string) ([]*quad.Quadruple, map[string]int, error) {
+	lines := strings.Split(src, "\n")
+	n := 0
+
+	count, err := parseCount(lines, n)
+	if err != nil {
+		return nil, nil, err
+	}
+	n++
+
+	if n+count > len(lines) {
+		return nil, nil, errutil.NewNoPosf("Expected %d quads, the file has %d lines", count, len(lines))
+	}
+
+	quads := make([]*quad.Quadruple, 0, count)
+	for i := 0; i < count; i++ {
+		q, err := parseQuadruple(lines[n], n+1)
+		if err != nil {
+			return nil, nil, err
+		}
+		quads = append(quads, q)
+		n++
+	}
+
+	count, err = parseCount(lines, n)
+	if err != nil {
+		return nil, nil, err
+	}
+	n++
+
+	if n+count > len(lines) {
+		return nil, nil, errutil.NewNoPosf("Expected %d constants, the file has %d lines", count, len(lines))
+	}
+
+	// The address is after the last space, since the constant itself can have spaces
+	consmap := make(map[string]int)
+	for i := 0; i < count; i++ {
+		sep := strings.LastIndex(lines[n], " ")
+		if sep == -1 {
+			return nil, nil, errutil.NewNoPosf("Line %d: Expected a constant and its address, got %q", n+1, lines[n])
+		}
+
+		addr, err := strconv.Atoi(lines[n][sep+1:])
+		if err != nil {
+			return nil, nil, errutil.NewNoPosf("Line %d: Invalid address %s", n+1, lines[n][sep+1:])
+		}
+
+		consmap[lines[n][:sep]] = addr
+		n++
+	}
+
+	return quads, consmap, nil
+}
+
+// LoadProgram reads a program from a bytecode file, or from an object file in the text format
+// created by ic.Generator.CreateFile, which has no function table
+func LoadProgram(path string) (*bytecode.Program, error) {
+	src, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytecode.IsBytecode(src) {
+		return bytecode.Decode(src)
+	}
+
+	quads, consmap, err := ParseObject(string(src))
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := bytecode.NewConstantPool(consmap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bytecode.Program{Quads: quads, Constants: pool}, nil
+}
@@ -12,6 +12,9 @@ import (
 
 var objectSize = semantics.ObjectSize
 
+// structHeader is the first cell of a struct, it holds the amount of fields that follow it
+type structHeader int
+
 //MemorySegment represent a single segment in the Memory struct
 type MemorySegment struct {
 	floats  	[]float64
@@ -24,6 +27,8 @@ type MemorySegment struct {
 	images		[]objects.Image
 	texts		[]objects.Text
 	backgrounds	[]objects.Background
+	structs		[]interface{}
+	lists		[]*objects.List
 	base     	mem.Address
 	name     	string
 }
@@ -267,12 +272,46 @@ func (ms *MemorySegment) SetValue(v interface{}, addr mem.Address) error {
 			}
 		}
 		return errutil.Newf("Cannot set non-square in Image address range")
+	case baseaddr < mem.StructOffset+1000: // Structs
+		typebaseaddr := int(baseaddr - mem.StructOffset)
+
+		// A whole struct fills its header and the cells of its fields, anything else is a single cell
+		if st, ok := v.(objects.Struct); ok {
+			ms.setStructCell(structHeader(len(st.Fields())), typebaseaddr)
+			for i, f := range st.Fields() {
+				ms.setStructCell(f, typebaseaddr+i+1)
+			}
+			return nil
+		}
+
+		ms.setStructCell(v, typebaseaddr)
+		return nil
+	case baseaddr < mem.ListOffset+1000: // Lists
+		if l, ok := v.(*objects.List); ok {
+			typebaseaddr := int(baseaddr - mem.ListOffset)
+			if len(ms.lists) <= typebaseaddr {
+				newslice := make([]*objects.List, typebaseaddr-len(ms.lists)+1)
+				ms.lists = append(ms.lists, newslice...)
+			}
+			ms.lists[typebaseaddr] = l
+			return nil
+		}
+		return errutil.Newf("Cannot set non-list in list address range")
 	default: // Error
 		return errutil.Newf("Address out of scope")
 	}
 
 }
 
+// setStructCell stores a value in a cell of the struct segment, growing it if needed
+func (ms *MemorySegment) setStructCell(v interface{}, typebaseaddr int) {
+	if len(ms.structs) <= typebaseaddr {
+		newslice := make([]interface{}, typebaseaddr-len(ms.structs)+1)
+		ms.structs = append(ms.structs, newslice...)
+	}
+	ms.structs[typebaseaddr] = v
+}
+
 func (ms *MemorySegment) SetAttribute(obj objects.Object, v interface{}, attAddr int) error {
 	attribute := semantics.ObjectAttributes[attAddr - 1]
 
@@ -447,6 +486,31 @@ func (ms *MemorySegment) GetValue(addr mem.Address) (interface{}, error) { // AQ
 		} else {
 			return ms.backgrounds[objBase / objectSize], nil
 		}
+	case baseaddr < mem.StructOffset+1000: // Struct
+		typebaseaddr := int(baseaddr - mem.StructOffset)
+		if len(ms.structs) <= typebaseaddr {
+			return nil, errutil.Newf("Referencing address out of scope")
+		}
+
+		// Reading the header of a struct gives a copy of the whole struct
+		size, ok := ms.structs[typebaseaddr].(structHeader)
+		if !ok {
+			return ms.structs[typebaseaddr], nil
+		}
+
+		if len(ms.structs) <= typebaseaddr+int(size) {
+			return nil, errutil.Newf("Referencing address out of scope")
+		}
+
+		fields := make([]interface{}, size)
+		copy(fields, ms.structs[typebaseaddr+1:typebaseaddr+1+int(size)])
+		return objects.NewStruct(fields), nil
+	case baseaddr < mem.ListOffset+1000: // List
+		typebaseaddr := int(baseaddr - mem.ListOffset)
+		if len(ms.lists) <= typebaseaddr || ms.lists[typebaseaddr] == nil {
+			return nil, errutil.Newf("Referencing address out of scope")
+		}
+		return ms.lists[typebaseaddr], nil
 	default: // Error
 		return nil, errutil.Newf("Address out of scope")
 	}
@@ -501,27 +565,35 @@ func (ms *MemorySegment) String() string {
 	}
 	builder.WriteString("    Strings:\n")
 	for i, v := range ms.strings {
-		builder.WriteString(fmt.Sprintf("      %d: %d\n", i, v))
+		builder.WriteString(fmt.Sprintf("      %d: %v\n", i, v))
 	}
 	builder.WriteString("    Squares:\n")
 	for i, v := range ms.squares {
-		builder.WriteString(fmt.Sprintf("      %d: %d\n", i, v))
+		builder.WriteString(fmt.Sprintf("      %d: %v\n", i, v))
 	}
 	builder.WriteString("    Circles:\n")
 	for i, v := range ms.circles {
-		builder.WriteString(fmt.Sprintf("      %d: %d\n", i, v))
+		builder.WriteString(fmt.Sprintf("      %d: %v\n", i, v))
 	}
 	builder.WriteString("    Images:\n")
 	for i, v := range ms.images {
-		builder.WriteString(fmt.Sprintf("      %d: %d\n", i, v))
+		builder.WriteString(fmt.Sprintf("      %d: %v\n", i, v))
 	}
 	builder.WriteString("    Texts:\n")
 	for i, v := range ms.texts {
-		builder.WriteString(fmt.Sprintf("      %d: %d\n", i, v))
+		builder.WriteString(fmt.Sprintf("      %d: %v\n", i, v))
 	}
 	builder.WriteString("    Backgrounds:\n")
 	for i, v := range ms.backgrounds {
-		builder.WriteString(fmt.Sprintf("      %d: %s\n", i, v))
+		builder.WriteString(fmt.Sprintf("      %d: %v\n", i, v))
+	}
+	builder.WriteString("    Structs:\n")
+	for i, v := range ms.structs {
+		builder.WriteString(fmt.Sprintf("      %d: %v\n", i, v))
+	}
+	builder.WriteString("    Lists:\n")
+	for i, v := range ms.lists {
+		builder.WriteString(fmt.Sprintf("      %d: %v\n", i, v))
 	}
 	return builder.String()
 }
@@ -538,6 +610,8 @@ func NewMemorySegment(base int, name string) *MemorySegment {
 		make([]objects.Image, 0),					// images
 		make([]objects.Text, 0),					// texts
 		make([]objects.Background, 0),				// backgrounds
+		make([]interface{}, 0),						// structs
+		make([]*objects.List, 0),					// lists
 		mem.Address(base),
 		name,
 	}
@@ -551,6 +625,7 @@ type Memory struct {
 	memtemp     *MemorySegment
 	memconstant *MemorySegment
 	memscope    *MemorySegment
+	heap        []*objects.List
 }
 
 func NewMemory() *Memory {
@@ -560,9 +635,17 @@ func NewMemory() *Memory {
 		NewMemorySegment(mem.Tempstart, "Temp"),
 		NewMemorySegment(mem.Constantstart, "Constant"),
 		NewMemorySegment(mem.Scopestart, "Scope"),
+		make([]*objects.List, 0),
 	}
 }
 
+// NewList creates an empty list in the heap, the cells of the lists reference it
+func (m *Memory) NewList() *objects.List {
+	l := objects.NewList()
+	m.heap = append(m.heap, l)
+	return l
+}
+
 // Get value takes an address and tries to retrieve the saved value by consulting its memory segments
 func (m *Memory) GetValue(addr mem.Address) (interface{}, error) {
 	switch {
@@ -648,6 +731,10 @@ func (m *Memory) String() string {
 	builder.WriteString(m.memtemp.String())
 	builder.WriteString(m.memconstant.String())
 	builder.WriteString(m.memscope.String())
+	builder.WriteString("  Heap:\n")
+	for i, l := range m.heap {
+		builder.WriteString(fmt.Sprintf("    %d: %v\n", i, l.Elems()))
+	}
 
 	return builder.String()
 }
\ No newline at end of file
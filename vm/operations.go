@@ -2,10 +2,15 @@ package vm
 
 import (
 	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/sdkvictor/golang-compiler/engine"
 	"github.com/sdkvictor/golang-compiler/mem"
 	"github.com/sdkvictor/golang-compiler/objects"
 	"github.com/sdkvictor/golang-compiler/semantics"
+	"github.com/sdkvictor/golang-compiler/types"
 	"github.com/sdkvictor/golang-compiler/vm/ar"
 	"github.com/mewkiz/pkg/errutil"
 )
@@ -35,6 +40,12 @@ func (vm *VirtualMachine) operationAdd(lop, rop, r mem.Address) error {
 			return err
 		}
 		return nil
+	} else if s1, s2, err := getStrings(lopv, ropv); err == nil {
+		result := s1 + s2
+		if err := vm.mm.SetValue(result, r); err != nil {
+			return err
+		}
+		return nil
 	}
 
 	return nil
@@ -96,6 +107,7 @@ func (vm *VirtualMachine) operationMult(lop, rop, r mem.Address) error {
 	return nil
 }
 
+// operationDiv divides the operands, the division of ints is truncated towards zero
 func (vm *VirtualMachine) operationDiv(lop, rop, r mem.Address) error {
 	lopv, err := vm.mm.GetValue(lop)
 	if err != nil {
@@ -109,7 +121,7 @@ func (vm *VirtualMachine) operationDiv(lop, rop, r mem.Address) error {
 
 	if f1, f2, err := getFloats(lopv, ropv); err == nil {
 		if f2 == 0 {
-			return errutil.Newf("Arithmethic exception, division by 0")
+			return errutil.Newf("Division by zero")
 		}
 		result := f1 / f2
 		if err := vm.mm.SetValue(result, r); err != nil {
@@ -118,7 +130,7 @@ func (vm *VirtualMachine) operationDiv(lop, rop, r mem.Address) error {
 		return nil
 	} else if i1, i2, err := getInts(lopv, ropv); err == nil {
 		if i2 == 0 {
-			return errutil.Newf("Arithmethic exception, division by 0")
+			return errutil.Newf("Division by zero")
 		}
 		result := i1 / i2
 		if err := vm.mm.SetValue(result, r); err != nil {
@@ -127,7 +139,42 @@ func (vm *VirtualMachine) operationDiv(lop, rop, r mem.Address) error {
 		return nil
 	}
 
-	return nil
+	return errutil.Newf("Cannot perform division on given types")
+}
+
+// operationMod is the remainder of the truncated division, so it has the sign of the left operand
+func (vm *VirtualMachine) operationMod(lop, rop, r mem.Address) error {
+	lopv, err := vm.mm.GetValue(lop)
+	if err != nil {
+		return err
+	}
+
+	ropv, err := vm.mm.GetValue(rop)
+	if err != nil {
+		return err
+	}
+
+	if f1, f2, err := getFloats(lopv, ropv); err == nil {
+		if f2 == 0 {
+			return errutil.Newf("Division by zero")
+		}
+		result := math.Mod(f1, f2)
+		if err := vm.mm.SetValue(result, r); err != nil {
+			return err
+		}
+		return nil
+	} else if i1, i2, err := getInts(lopv, ropv); err == nil {
+		if i2 == 0 {
+			return errutil.Newf("Division by zero")
+		}
+		result := i1 % i2
+		if err := vm.mm.SetValue(result, r); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	return errutil.Newf("Cannot perform modulo on given types")
 }
 
 
@@ -211,7 +258,9 @@ func (vm *VirtualMachine) operationNot(lop, rop, r mem.Address) error {
 	return nil
 }
 
-func (vm *VirtualMachine) operationGt(lop, rop, r mem.Address) error {
+// operationCompare stores in r if the comparison of the operands, which is negative, zero or positive
+// like strings.Compare, satisfies the condition
+func (vm *VirtualMachine) operationCompare(lop, rop, r mem.Address, cond func(int) bool) error {
 	lopv, err := vm.mm.GetValue(lop)
 	if err != nil {
 		return err
@@ -222,24 +271,56 @@ func (vm *VirtualMachine) operationGt(lop, rop, r mem.Address) error {
 		return err
 	}
 
-	if f1, f2, err := getFloats(lopv, ropv); err == nil {
-		result := f1 > f2
-		if err := vm.mm.SetValue(result, r); err != nil {
+	c, err := compareValues(lopv, ropv)
+	if err != nil {
+		return err
+	}
+
+	if err := vm.mm.SetValue(cond(c), r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (vm *VirtualMachine) operationNeg(lop, rop, r mem.Address) error {
+	lopv, err := vm.mm.GetValue(lop)
+	if err != nil {
+		return err
+	}
+
+	if f, err := getFloat(lopv); err == nil {
+		if err := vm.mm.SetValue(-f, r); err != nil {
 			return err
 		}
 		return nil
-	} else if i1, i2, err := getInts(lopv, ropv); err == nil {
-		result := i1 > i2
-		if err := vm.mm.SetValue(result, r); err != nil {
+	} else if i, err := getInt(lopv); err == nil {
+		if err := vm.mm.SetValue(-i, r); err != nil {
 			return err
 		}
 		return nil
 	}
 
-	return nil
+	return errutil.Newf("Cannot perform negation on given type")
+}
+
+func (vm *VirtualMachine) operationGt(lop, rop, r mem.Address) error {
+	return vm.operationCompare(lop, rop, r, func(c int) bool { return c > 0 })
 }
 
 func (vm *VirtualMachine) operationLt(lop, rop, r mem.Address) error {
+	return vm.operationCompare(lop, rop, r, func(c int) bool { return c < 0 })
+}
+
+func (vm *VirtualMachine) operationGtEqual(lop, rop, r mem.Address) error {
+	return vm.operationCompare(lop, rop, r, func(c int) bool { return c >= 0 })
+}
+
+func (vm *VirtualMachine) operationLtEqual(lop, rop, r mem.Address) error {
+	return vm.operationCompare(lop, rop, r, func(c int) bool { return c <= 0 })
+}
+
+func (vm *VirtualMachine) operationEqual(lop, rop, r mem.Address) error {
 	lopv, err := vm.mm.GetValue(lop)
 	if err != nil {
 		return err
@@ -250,24 +331,19 @@ func (vm *VirtualMachine) operationLt(lop, rop, r mem.Address) error {
 		return err
 	}
 
-	if f1, f2, err := getFloats(lopv, ropv); err == nil {
-		result := f1 < f2
-		if err := vm.mm.SetValue(result, r); err != nil {
-			return err
-		}
-		return nil
-	} else if i1, i2, err := getInts(lopv, ropv); err == nil {
-		result := i1 < i2
-		if err := vm.mm.SetValue(result, r); err != nil {
-			return err
-		}
-		return nil
+	result, err := equalValues(lopv, ropv)
+	if err != nil {
+		return err
+	}
+
+	if err := vm.mm.SetValue(result, r); err != nil {
+		return err
 	}
 
 	return nil
 }
 
-func (vm *VirtualMachine) operationEqual(lop, rop, r mem.Address) error {
+func (vm *VirtualMachine) operationNotEqual(lop, rop, r mem.Address) error {
 	lopv, err := vm.mm.GetValue(lop)
 	if err != nil {
 		return err
@@ -278,39 +354,16 @@ func (vm *VirtualMachine) operationEqual(lop, rop, r mem.Address) error {
 		return err
 	}
 
-	if f1, f2, err := getFloats(lopv, ropv); err == nil {
-		result := f1 == f2
-		if err := vm.mm.SetValue(result, r); err != nil {
-			return err
-		}
-		return nil
-	} else if c1, c2, err := getChars(lopv, ropv); err == nil {
-		result := c1 == c2
-		if err := vm.mm.SetValue(result, r); err != nil {
-			return err
-		}
-		return nil
-	} else if b1, b2, err := getBools(lopv, ropv); err == nil {
-		result := b1 == b2
-		if err := vm.mm.SetValue(result, r); err != nil {
-			return err
-		}
-		return nil
-	} else if i1, i2, err := getInts(lopv, ropv); err == nil {
-		result := i1 == i2
-		if err := vm.mm.SetValue(result, r); err != nil {
-			return err
-		}
-		return nil
-	} else if s1, s2, err := getStrings(lopv, ropv); err == nil {
-		result := s1 == s2
-		if err := vm.mm.SetValue(result, r); err != nil {
-			return err
-		}
-		return nil
+	result, err := equalValues(lopv, ropv)
+	if err != nil {
+		return err
+	}
+
+	if err := vm.mm.SetValue(!result, r); err != nil {
+		return err
 	}
 
-	return errutil.Newf("Cannot perform equal operation on given types")
+	return nil
 }
 
 func (vm *VirtualMachine) operationPrint(lop, rop, r mem.Address) error {
@@ -340,6 +393,40 @@ func (vm *VirtualMachine) operationParam(lop, rop, r mem.Address) error {
 		size = 1
 	}
 
+	// A list is passed by reference
+	if typedaddr >= mem.ListOffset {
+		lopv, err := vm.mm.GetValue(lop)
+		if err != nil {
+			return err
+		}
+
+		l, err := getList(lopv)
+		if err != nil {
+			return err
+		}
+		nextcall.AddListParam(l)
+		return nil
+	}
+
+	// Every element of an array of structs is as far from the next one as the cells of a struct
+	if typedaddr >= mem.StructOffset {
+		addr := lop
+		for i := 0; i < size; i++ {
+			lopv, err := vm.mm.GetValue(addr)
+			if err != nil {
+				return err
+			}
+
+			s1, err := getStruct(lopv)
+			if err != nil {
+				return err
+			}
+			nextcall.AddStructParam(s1)
+			addr += mem.Address(len(s1.Fields()) + 1)
+		}
+		return nil
+	}
+
 	for i := 0; i < size; i++ {
 		lopv, err := vm.mm.GetValue(mem.Address(int(lop) + i))
 		if err != nil {
@@ -415,6 +502,27 @@ func (vm *VirtualMachine) operationParam(lop, rop, r mem.Address) error {
 	return nil
 }
 
+// operationThis passes the object of a method call as its first parameter and keeps its address,
+// so the changes the method makes to it are copied back when it returns
+func (vm *VirtualMachine) operationThis(lop, rop, r mem.Address) error {
+	nextcall := vm.pendingcalls.Top()
+
+	lopv, err := vm.mm.GetValue(lop)
+	if err != nil {
+		return err
+	}
+
+	s1, err := getStruct(lopv)
+	if err != nil {
+		return err
+	}
+
+	nextcall.AddStructParam(s1)
+	nextcall.SetThis(lop)
+
+	return nil
+}
+
 func (vm *VirtualMachine) operationCall(lop, rop, r mem.Address) error {
 	// First we get the current call
 	currcall := vm.ar.Top()
@@ -466,6 +574,17 @@ func (vm *VirtualMachine) operationRet(lop, rop, r mem.Address) error {
 	//fmt.Printf("RETURN %s\n", vm.mm)
 	// We get the return instruction pointer
 	retip := vm.ar.Top().Retip()
+
+	// The object of a method is its first struct parameter, it is read before the memory of the caller is restored
+	this := vm.ar.Top().This()
+	var thisv interface{}
+	if this != -1 {
+		v, err := vm.mm.GetValue(mem.Address(mem.Localstart + mem.StructOffset))
+		if err != nil {
+			return err
+		}
+		thisv = v
+	}
 	
 	// We kill the current activation record
 	vm.ar.Pop()
@@ -505,6 +624,12 @@ func (vm *VirtualMachine) operationRet(lop, rop, r mem.Address) error {
 		return err
 	}
 
+	if this != -1 {
+		if err := vm.mm.SetValue(thisv, this); err != nil {
+			return err
+		}
+	}
+
 	// We check if the function is a void function
 	if void {
 		// If it's a void, then we just update the ip and return
@@ -555,6 +680,14 @@ func (vm *VirtualMachine) operationRet(lop, rop, r mem.Address) error {
 		if err := vm.mm.SetValue(i, callSaveAddr); err != nil {
 			return err
 		}
+	} else if st, err := getStruct(retv); err == nil {
+		if err := vm.mm.SetValue(st, callSaveAddr); err != nil {
+			return err
+		}
+	} else if l, err := getList(retv); err == nil {
+		if err := vm.mm.SetValue(l, callSaveAddr); err != nil {
+			return err
+		}
 	}else {
 		return errutil.Newf("Cannot get valid form for value")
 	}
@@ -687,6 +820,18 @@ func (vm *VirtualMachine) operationInit(lop, rop, r mem.Address) error {
 	startAddr := int(lop)
 	size := int(rop)
 	t := int (r)
+
+	// The header of a struct takes the amount of fields instead of a size, the fields are initialized
+	// by their own quads
+	if t == types.StructInitCode {
+		return vm.mm.SetValue(structHeader(size), lop)
+	}
+
+	// Every declaration of a list starts a new empty one
+	if t == types.ListInitCode {
+		return vm.mm.SetValue(vm.mm.NewList(), lop)
+	}
+
 	for i := 0; i < size; i++ {
 		switch t {
 		case 1:
@@ -901,12 +1046,12 @@ func (vm *VirtualMachine) operationCheckCollision(lop, rop, r mem.Address) error
 
 	if l, err := getSquare(lopv); err==nil{ 
 		if l2, err := getCircle(ropv); err==nil{
-			res := vm.engine.IntersectSC(l, l2) //is Square - Circle
+			res := engine.IntersectSC(l, l2) //is Square - Circle
 			if err := vm.mm.SetValue(res, r); err != nil {
 				return err
 			}
 		}else if l2, err := getSquare(ropv); err==nil{
-			res := vm.engine.IntersectSquare(l,l2) //is Square - Square
+			res := engine.IntersectSquare(l,l2) //is Square - Square
 			if err := vm.mm.SetValue(res, r); err != nil {
 				return err
 			}
@@ -915,12 +1060,12 @@ func (vm *VirtualMachine) operationCheckCollision(lop, rop, r mem.Address) error
 		}
 	} else if l, err := getCircle(lopv); err==nil{
 		if l2, err := getCircle(ropv); err==nil{
-			res := vm.engine.IntersectCircle(l, l2) //is Circle - Circle
+			res := engine.IntersectCircle(l, l2) //is Circle - Circle
 			if err := vm.mm.SetValue(res, r); err != nil {
 				return err
 			}
 		}else if l2, err := getSquare(ropv); err==nil{
-			res := vm.engine.IntersectCS(l,l2) //is Circle - Square
+			res := engine.IntersectCS(l,l2) //is Circle - Square
 			if err := vm.mm.SetValue(res, r); err != nil {
 				return err
 			}
@@ -932,3 +1077,333 @@ func (vm *VirtualMachine) operationCheckCollision(lop, rop, r mem.Address) error
 	}
 	return nil
 }
+
+// listOperand gets the list referenced by a cell of the list segment
+func (vm *VirtualMachine) listOperand(addr mem.Address) (*objects.List, error) {
+	v, err := vm.mm.GetValue(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return getList(v)
+}
+
+// listIndex gets an index of a list and checks it is one of its elements
+func (vm *VirtualMachine) listIndex(l *objects.List, addr mem.Address) (int, error) {
+	v, err := vm.mm.GetValue(addr)
+	if err != nil {
+		return 0, err
+	}
+
+	i, err := getInt(v)
+	if err != nil {
+		return 0, err
+	}
+
+	if i < 0 || i >= l.Len() {
+		return 0, errutil.Newf("Index %d out of bounds for list of size %d", i, l.Len())
+	}
+
+	return i, nil
+}
+
+// operationListAppend adds a copy of the value at lop to the end of the list at r
+func (vm *VirtualMachine) operationListAppend(lop, rop, r mem.Address) error {
+	l, err := vm.listOperand(r)
+	if err != nil {
+		return err
+	}
+
+	v, err := vm.mm.GetValue(lop)
+	if err != nil {
+		return err
+	}
+
+	l.Append(v)
+	return nil
+}
+
+func (vm *VirtualMachine) operationListLen(lop, rop, r mem.Address) error {
+	l, err := vm.listOperand(lop)
+	if err != nil {
+		return err
+	}
+
+	return vm.mm.SetValue(l.Len(), r)
+}
+
+// operationListRemove takes out the element of the list at lop with the index at rop
+func (vm *VirtualMachine) operationListRemove(lop, rop, r mem.Address) error {
+	l, err := vm.listOperand(lop)
+	if err != nil {
+		return err
+	}
+
+	i, err := vm.listIndex(l, rop)
+	if err != nil {
+		return err
+	}
+
+	l.Remove(i)
+	return nil
+}
+
+func (vm *VirtualMachine) operationListClear(lop, rop, r mem.Address) error {
+	l, err := vm.listOperand(lop)
+	if err != nil {
+		return err
+	}
+
+	l.Clear()
+	return nil
+}
+
+// operationListGet copies the element of the list at lop with the index at rop to r
+func (vm *VirtualMachine) operationListGet(lop, rop, r mem.Address) error {
+	l, err := vm.listOperand(lop)
+	if err != nil {
+		return err
+	}
+
+	i, err := vm.listIndex(l, rop)
+	if err != nil {
+		return err
+	}
+
+	return vm.mm.SetValue(l.Get(i), r)
+}
+
+// operationListSet replaces the element of the list at r with the index at rop by the value at lop
+func (vm *VirtualMachine) operationListSet(lop, rop, r mem.Address) error {
+	l, err := vm.listOperand(r)
+	if err != nil {
+		return err
+	}
+
+	i, err := vm.listIndex(l, rop)
+	if err != nil {
+		return err
+	}
+
+	v, err := vm.mm.GetValue(lop)
+	if err != nil {
+		return err
+	}
+
+	l.Set(i, v)
+	return nil
+}
+
+// stringOperand gets the characters of the string at addr
+func (vm *VirtualMachine) stringOperand(addr mem.Address) ([]rune, error) {
+	v, err := vm.mm.GetValue(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := getString(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return []rune(s), nil
+}
+
+// operationStrIndex copies the character of the string at lop with the index at rop to r
+func (vm *VirtualMachine) operationStrIndex(lop, rop, r mem.Address) error {
+	s, err := vm.stringOperand(lop)
+	if err != nil {
+		return err
+	}
+
+	v, err := vm.mm.GetValue(rop)
+	if err != nil {
+		return err
+	}
+
+	i, err := getInt(v)
+	if err != nil {
+		return err
+	}
+
+	if i < 0 || i >= len(s) {
+		return errutil.Newf("Index %d out of bounds for string of length %d", i, len(s))
+	}
+
+	return vm.mm.SetValue(s[i], r)
+}
+
+func (vm *VirtualMachine) operationStrLen(lop, rop, r mem.Address) error {
+	s, err := vm.stringOperand(lop)
+	if err != nil {
+		return err
+	}
+
+	return vm.mm.SetValue(len(s), r)
+}
+
+// operationSubstr copies to r the part of the string at lop that starts at the index at rop,
+// the length of the part is in the cell after it
+func (vm *VirtualMachine) operationSubstr(lop, rop, r mem.Address) error {
+	s, err := vm.stringOperand(lop)
+	if err != nil {
+		return err
+	}
+
+	startv, err := vm.mm.GetValue(rop)
+	if err != nil {
+		return err
+	}
+
+	lengthv, err := vm.mm.GetValue(rop + 1)
+	if err != nil {
+		return err
+	}
+
+	start, length, err := getInts(startv, lengthv)
+	if err != nil {
+		return err
+	}
+
+	if start < 0 || length < 0 || start+length > len(s) {
+		return errutil.Newf("Substring from %d with length %d out of bounds for string of length %d", start, length, len(s))
+	}
+
+	return vm.mm.SetValue(string(s[start:start+length]), r)
+}
+
+// operationIndexOf stores in r the index of the first match of the string or char at rop
+// in the string at lop, or -1 if there is none
+func (vm *VirtualMachine) operationIndexOf(lop, rop, r mem.Address) error {
+	s, err := vm.stringOperand(lop)
+	if err != nil {
+		return err
+	}
+
+	v, err := vm.mm.GetValue(rop)
+	if err != nil {
+		return err
+	}
+
+	var sub string
+	if c, err := getChar(v); err == nil {
+		sub = string(c)
+	} else if str, err := getString(v); err == nil {
+		sub = str
+	} else {
+		return err
+	}
+
+	i := strings.Index(string(s), sub)
+	if i >= 0 {
+		// The index is counted in characters, not in bytes
+		i = len([]rune(string(s)[:i]))
+	}
+
+	return vm.mm.SetValue(i, r)
+}
+
+// operationToString stores in r the value at lop written the way print shows it
+func (vm *VirtualMachine) operationToString(lop, rop, r mem.Address) error {
+	v, err := vm.mm.GetValue(lop)
+	if err != nil {
+		return err
+	}
+
+	return vm.mm.SetValue(formatValue(v), r)
+}
+
+func (vm *VirtualMachine) operationParseInt(lop, rop, r mem.Address) error {
+	s, err := vm.stringOperand(lop)
+	if err != nil {
+		return err
+	}
+
+	i, err := strconv.Atoi(string(s))
+	if err != nil {
+		return errutil.Newf("Cannot parse %q as int", string(s))
+	}
+
+	return vm.mm.SetValue(i, r)
+}
+
+func (vm *VirtualMachine) operationParseFloat(lop, rop, r mem.Address) error {
+	s, err := vm.stringOperand(lop)
+	if err != nil {
+		return err
+	}
+
+	f, err := strconv.ParseFloat(string(s), 64)
+	if err != nil {
+		return errutil.Newf("Cannot parse %q as float", string(s))
+	}
+
+	return vm.mm.SetValue(f, r)
+}
+
+func (vm *VirtualMachine) operationIntToFloat(lop, rop, r mem.Address) error {
+	v, err := vm.mm.GetValue(lop)
+	if err != nil {
+		return err
+	}
+
+	i, err := getInt(v)
+	if err != nil {
+		return err
+	}
+
+	return vm.mm.SetValue(float64(i), r)
+}
+
+// operationFloatToInt truncates the float at lop towards zero
+func (vm *VirtualMachine) operationFloatToInt(lop, rop, r mem.Address) error {
+	v, err := vm.mm.GetValue(lop)
+	if err != nil {
+		return err
+	}
+
+	f, err := getFloat(v)
+	if err != nil {
+		return err
+	}
+
+	if math.IsNaN(f) || f >= math.MaxInt64 || f < math.MinInt64 {
+		return errutil.Newf("Cannot convert %v to int", f)
+	}
+
+	return vm.mm.SetValue(int(f), r)
+}
+
+// operationIntToChar stores in r the character whose code is the int at lop
+func (vm *VirtualMachine) operationIntToChar(lop, rop, r mem.Address) error {
+	v, err := vm.mm.GetValue(lop)
+	if err != nil {
+		return err
+	}
+
+	i, err := getInt(v)
+	if err != nil {
+		return err
+	}
+
+	if i < 0 || i > utf8.MaxRune || !utf8.ValidRune(rune(i)) {
+		return errutil.Newf("Cannot convert %d to char, it is not a valid character code", i)
+	}
+
+	return vm.mm.SetValue(rune(i), r)
+}
+
+// operationCharToInt stores in r the code of the character at lop
+func (vm *VirtualMachine) operationCharToInt(lop, rop, r mem.Address) error {
+	v, err := vm.mm.GetValue(lop)
+	if err != nil {
+		return err
+	}
+
+	c, err := getChar(v)
+	if err != nil {
+		return err
+	}
+
+	return vm.mm.SetValue(int(c), r)
+}
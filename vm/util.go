@@ -2,6 +2,7 @@ package vm
 
 import (
 	"os"
+	"strings"
 
 	"github.com/sdkvictor/golang-compiler/mem"
 	"github.com/sdkvictor/golang-compiler/semantics"
@@ -152,6 +153,51 @@ func getStrings(v1, v2 interface{}) (string, string, error) {
 	return i1, i2, nil
 }
 
+// compareValues returns a negative number if v1 is less than v2, zero if they are equal and a positive number
+// otherwise. Strings are compared lexicographically
+func compareValues(v1, v2 interface{}) (int, error) {
+	if f1, f2, err := getFloats(v1, v2); err == nil {
+		switch {
+		case f1 < f2:
+			return -1, nil
+		case f1 > f2:
+			return 1, nil
+		}
+		return 0, nil
+	} else if i1, i2, err := getInts(v1, v2); err == nil {
+		switch {
+		case i1 < i2:
+			return -1, nil
+		case i1 > i2:
+			return 1, nil
+		}
+		return 0, nil
+	} else if c1, c2, err := getChars(v1, v2); err == nil {
+		return int(c1) - int(c2), nil
+	} else if s1, s2, err := getStrings(v1, v2); err == nil {
+		return strings.Compare(s1, s2), nil
+	}
+
+	return 0, errutil.NewNoPosf("Cannot compare values of types %T and %T", v1, v2)
+}
+
+// equalValues tells if two values of a basic type are equal
+func equalValues(v1, v2 interface{}) (bool, error) {
+	if f1, f2, err := getFloats(v1, v2); err == nil {
+		return f1 == f2, nil
+	} else if c1, c2, err := getChars(v1, v2); err == nil {
+		return c1 == c2, nil
+	} else if b1, b2, err := getBools(v1, v2); err == nil {
+		return b1 == b2, nil
+	} else if i1, i2, err := getInts(v1, v2); err == nil {
+		return i1 == i2, nil
+	} else if s1, s2, err := getStrings(v1, v2); err == nil {
+		return s1 == s2, nil
+	}
+
+	return false, errutil.NewNoPosf("Cannot compare values of types %T and %T", v1, v2)
+}
+
 func getSquare(v interface{}) (objects.Square, error) {
 	in, ok := v.(objects.Square)
 	if !ok {
@@ -197,6 +243,23 @@ func getBackground(v interface{}) (objects.Background, error) {
 	return in, nil
 }
 
+func getStruct(v interface{}) (objects.Struct, error) {
+	in, ok := v.(objects.Struct)
+	if !ok {
+		return objects.Struct{}, errutil.NewNoPosf("Cannot convert current value to struct")
+	}
+
+	return in, nil
+}
+
+func getList(v interface{}) (*objects.List, error) {
+	in, ok := v.(*objects.List)
+	if !ok {
+		return nil, errutil.NewNoPosf("Cannot convert current value to list")
+	}
+
+	return in, nil
+}
 
 func getTypeAddr(addr mem.Address) int {
 	switch {
@@ -260,6 +323,14 @@ func (vm *VirtualMachine) copyTempToAR(a *ar.ActivationRecord) error {
 		a.AddBackgroundTemp(b, mem.Address((i*objSize)+mem.BackgroundOffset+mem.Tempstart))
 	}
 
+	for i, cell := range mstemp.structs {
+		a.AddStructTemp(cell, mem.Address(i+mem.StructOffset+mem.Tempstart))
+	}
+
+	for i, l := range mstemp.lists {
+		a.AddListTemp(l, mem.Address(i+mem.ListOffset+mem.Tempstart))
+	}
+
 	return nil
 }
 
@@ -310,6 +381,14 @@ func (vm *VirtualMachine) copyLocalToAR(a *ar.ActivationRecord) error {
 		a.AddBackgroundLocal(b, mem.Address((i*objSize)+mem.BackgroundOffset+mem.Localstart))
 	}
 
+	for i, cell := range mslocal.structs {
+		a.AddStructLocal(cell, mem.Address(i+mem.StructOffset+mem.Localstart))
+	}
+
+	for i, l := range mslocal.lists {
+		a.AddListLocal(l, mem.Address(i+mem.ListOffset+mem.Localstart))
+	}
+
 	return nil
 }
 
@@ -375,6 +454,18 @@ func (vm *VirtualMachine) copyTempToMemory(a *ar.ActivationRecord) error {
 		}
 	}
 
+	for _, p := range a.Structtemps() {
+		if err := vm.mm.SetValue(p.Value(), p.Addr()); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range a.Listtemps() {
+		if err := vm.mm.SetValue(p.Value(), p.Addr()); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -439,6 +530,18 @@ func (vm *VirtualMachine) copyParamsToLocal(a *ar.ActivationRecord) error {
 		}
 	}
 
+	for _, p := range a.Structparams() {
+		if err := vm.mm.SetValue(p.Value(), p.Addr()); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range a.Listparams() {
+		if err := vm.mm.SetValue(p.Value(), p.Addr()); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -460,8 +563,9 @@ func getOffsetObject(offset int, addr mem.Address) (int, error) {
 		return 0, errutil.Newf("Address out of scope")
 	}
 
-	// If addr is less than SquareOffset then it is a basic type
-	if addr < mem.SquareOffset {
+	// If addr is less than SquareOffset then it is a basic type. The offset of an element of an array
+	// of structs is already multiplied by the size of the struct
+	if addr < mem.SquareOffset || addr >= mem.StructOffset {
 		return offset, nil
 	}
 	
@@ -2,9 +2,9 @@ package vm
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 
+	"github.com/sdkvictor/golang-compiler/bytecode"
 	"github.com/sdkvictor/golang-compiler/mem"
 	"github.com/sdkvictor/golang-compiler/quad"
 	"github.com/sdkvictor/golang-compiler/engine"
@@ -21,7 +21,42 @@ type VirtualMachine struct {
 	mm           *Memory
 	ar           *ar.ArStack
 	pendingcalls *ar.ArStack
-	engine 	     *engine.Engine
+	engine 	     engine.Engine
+	file         string
+	positions    []bytecode.Position
+	functions    map[mem.Address]string
+}
+
+// frame is an activation record of the stack, with the function it belongs to and the quad it is at
+type frame struct {
+	function string
+	ip       int
+}
+
+// RuntimeError is an error found while executing a quad, located in the source with the call stack
+// of the program at that moment
+type RuntimeError struct {
+	Err      error
+	Location string
+	Stack    []string
+}
+
+// Error shows the location and the message, followed by a line for every function of the call stack
+func (e *RuntimeError) Error() string {
+	var builder strings.Builder
+
+	msg := e.Err.Error()
+	// The position of the virtual machine code where the error was created is not useful in a trace
+	if info, ok := e.Err.(*errutil.ErrInfo); ok && info.Err != nil {
+		msg = info.Err.Error()
+	}
+
+	builder.WriteString(fmt.Sprintf("%s: %s", e.Location, msg))
+	for _, call := range e.Stack {
+		builder.WriteString(fmt.Sprintf("\n    at %s", call))
+	}
+
+	return builder.String()
 }
 
 // String represents the vm in a strctured format so that it can be easily debugged
@@ -46,67 +81,58 @@ func (vm *VirtualMachine) String() string {
 
 // loadConstants takes an array of strings and converts them to the corresponding constants in memory
 func (vm *VirtualMachine) LoadConstants(constantmap map[string]int) error {
-	for cons, addr := range constantmap {
-		switch {
-		case addr < mem.Constantstart+mem.CharOffset: // Float
-			f, err := strconv.ParseFloat(cons, 64)
-			if err != nil {
-				return err
-			}
-
-			if err := vm.mm.SetValue(f, mem.Address(addr)); err != nil {
-				return err
-			}
-		case addr < mem.Constantstart+mem.BoolOffset: // Char
-			char := rune(cons[0])
-
-			if err := vm.mm.SetValue(char, mem.Address(addr)); err != nil {
-				return err
-			}
-		case addr < mem.Constantstart+mem.IntOffset: // Bool
-			boolean, err := strconv.ParseBool(cons)
-			if err != nil {
-				return err
-			}
+	pool, err := bytecode.NewConstantPool(constantmap)
+	if err != nil {
+		return err
+	}
 
-			if err := vm.mm.SetValue(boolean, mem.Address(addr)); err != nil {
-				return err
-			}
-		case addr < mem.Constantstart+mem.StringOffset: // Int
-			i, err := strconv.Atoi(cons)
-			if err != nil {
-				return err
-			}
+	return vm.LoadConstantPool(pool)
+}
 
-			if err := vm.mm.SetValue(i, mem.Address(addr)); err != nil {
-				return err
-			}
-		case addr < mem.Constantstart+mem.SquareOffset: // String
-			if err := vm.mm.SetValue(cons, mem.Address(addr)); err != nil {
-				return err
-			}
-		default:
+// LoadConstantPool stores every constant of the pool in its address
+func (vm *VirtualMachine) LoadConstantPool(pool []bytecode.Constant) error {
+	for _, c := range pool {
+		if c.Addr < mem.Constantstart || c.Addr >= mem.Constantstart+mem.SquareOffset {
 			return errutil.Newf("Cannot set non-constant value")
 		}
+
+		if err := vm.mm.SetValue(c.Value, c.Addr); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
 func (vm *VirtualMachine) printOutput(v interface{}) {
+	fmt.Printf("%s\n", formatValue(v))
+}
+
+// formatValue converts a value of the memory to the text printed by the program
+func formatValue(v interface{}) string {
 	if c, ok := v.(rune); ok {
-		fmt.Printf("%c\n", c)
+		return fmt.Sprintf("%c", c)
 	} else if f, ok := v.(float64); ok {
 		if f == float64(int64(f)) {
-			fmt.Printf("%d\n", int64(f))
-		} else {
-			fmt.Printf("%f\n", f)
+			return fmt.Sprintf("%d", int64(f))
 		}
+		return fmt.Sprintf("%f", f)
 	} else if o, ok := v.(objects.Object); ok {
-		fmt.Printf("%s\n", o.String())
-	} else {
-		fmt.Printf("%v\n", v)
+		return o.String()
+	} else if st, ok := v.(objects.Struct); ok {
+		fields := make([]string, 0, len(st.Fields()))
+		for _, f := range st.Fields() {
+			fields = append(fields, formatValue(f))
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	} else if l, ok := v.(*objects.List); ok {
+		elems := make([]string, 0, l.Len())
+		for _, e := range l.Elems() {
+			elems = append(elems, formatValue(e))
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
 	}
+	return fmt.Sprintf("%v", v)
 }
 
 // executeNextInstruction indexes the next quadruple with the instruction pointer and
@@ -162,11 +188,36 @@ func (vm *VirtualMachine) executeNextInstruction() error {
 			return err
 		}
 		vm.ip++
+	case quad.LtEqual:
+		if err := vm.operationLtEqual(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.GtEqual:
+		if err := vm.operationGtEqual(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
 	case quad.Equal:
 		if err := vm.operationEqual(q.Lop(), q.Rop(), q.R()); err != nil {
 			return err
 		}
 		vm.ip++
+	case quad.Mod:
+		if err := vm.operationMod(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.Neg:
+		if err := vm.operationNeg(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.NotEqual:
+		if err := vm.operationNotEqual(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
 	case quad.Print:
 		if err := vm.operationPrint(q.Lop(), q.Rop(), q.R()); err != nil {
 			return err
@@ -182,6 +233,11 @@ func (vm *VirtualMachine) executeNextInstruction() error {
 			return err
 		}
 		vm.ip++
+	case quad.This:
+		if err := vm.operationThis(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
 	case quad.Call:
 		if err := vm.operationCall(q.Lop(), q.Rop(), q.R()); err != nil {
 			return err
@@ -269,14 +325,95 @@ func (vm *VirtualMachine) executeNextInstruction() error {
 			return err
 		}
 		vm.ip++
+	case quad.ListAppend:
+		if err := vm.operationListAppend(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.ListLen:
+		if err := vm.operationListLen(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.ListRemove:
+		if err := vm.operationListRemove(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.ListClear:
+		if err := vm.operationListClear(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.ListGet:
+		if err := vm.operationListGet(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.ListSet:
+		if err := vm.operationListSet(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.StrIndex:
+		if err := vm.operationStrIndex(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.StrLen:
+		if err := vm.operationStrLen(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.Substr:
+		if err := vm.operationSubstr(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.IndexOf:
+		if err := vm.operationIndexOf(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.ToString:
+		if err := vm.operationToString(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.ParseInt:
+		if err := vm.operationParseInt(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.ParseFloat:
+		if err := vm.operationParseFloat(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.IntToFloat:
+		if err := vm.operationIntToFloat(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.FloatToInt:
+		if err := vm.operationFloatToInt(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.IntToChar:
+		if err := vm.operationIntToChar(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
+	case quad.CharToInt:
+		if err := vm.operationCharToInt(q.Lop(), q.Rop(), q.R()); err != nil {
+			return err
+		}
+		vm.ip++
 	default:
 		return errutil.Newf("Invalid Quad %s", q.Op().String())
 	}
 
-	if vm.engine.WindowClosed() {
-		return nil
-	}
-
 	return nil
 }
 
@@ -289,20 +426,95 @@ func (vm *VirtualMachine) Run() error {
 
 	//Setup pixel
 
-	// Create the main activation record
-	mainAR := ar.NewActivationRecord()
-	// Set return to the end of the quads to end execution
-	mainAR.SetRetIp(len(vm.quads))
-	vm.ar.Push(mainAR)
+	vm.start()
 
-	for vm.ip < len(vm.quads) {
+	for !vm.finished() {
 		if err := vm.executeNextInstruction(); err != nil {
-			return err
+			return vm.runtimeError(err)
 		}
 	}
 
 	return nil
 }
+
+// LoadDebugInfo takes the source file, the positions of the quads and the function table of the program,
+// which are used to locate runtime errors
+func (vm *VirtualMachine) LoadDebugInfo(prog *bytecode.Program) {
+	vm.file = prog.File
+	vm.positions = prog.Positions
+
+	for _, f := range prog.Functions {
+		vm.functions[f.Loc] = f.Name
+	}
+}
+
+// position returns the source position of a quad, the line is 0 if it does not have one
+func (vm *VirtualMachine) position(ip int) bytecode.Position {
+	if ip < 0 || ip >= len(vm.positions) {
+		return bytecode.Position{}
+	}
+	return vm.positions[ip]
+}
+
+// location shows the source position of a quad as file:line:col, or its index if it has no position
+func (vm *VirtualMachine) location(ip int) string {
+	pos := vm.position(ip)
+	if pos.Line == 0 {
+		return fmt.Sprintf("quad %d", ip)
+	}
+
+	file := vm.file
+	if file == "" {
+		file = "<unknown>"
+	}
+	return fmt.Sprintf("%s:%d:%d", file, pos.Line, pos.Column)
+}
+
+// callStack walks the activation records from the current one to main. The return ip of a record
+// is the Call quad of its caller, which also tells which function the record belongs to
+func (vm *VirtualMachine) callStack() []frame {
+	frames := make([]frame, 0)
+	ip := vm.ip
+
+	for _, record := range vm.ar.Records() {
+		name := "main"
+		if retip := record.Retip(); retip < len(vm.quads) {
+			loc := vm.quads[retip].Lop()
+			if name = vm.functions[loc]; name == "" {
+				name = fmt.Sprintf("function at quad %d", loc)
+			}
+		}
+
+		frames = append(frames, frame{name, ip})
+		ip = record.Retip()
+	}
+
+	return frames
+}
+
+// runtimeError locates an error of the current quad
+func (vm *VirtualMachine) runtimeError(err error) error {
+	stack := make([]string, 0)
+	for _, f := range vm.callStack() {
+		stack = append(stack, fmt.Sprintf("%s (%s)", f.function, vm.location(f.ip)))
+	}
+
+	return &RuntimeError{err, vm.location(vm.ip), stack}
+}
+
+// start creates the activation record of main
+func (vm *VirtualMachine) start() {
+	mainAR := ar.NewActivationRecord()
+	// Set return to the end of the quads to end execution
+	mainAR.SetRetIp(len(vm.quads))
+	vm.ar.Push(mainAR)
+}
+
+// finished checks if the execution reached the end of the quads. Execution also ends once the
+// window of the game is closed
+func (vm *VirtualMachine) finished() bool {
+	return vm.ip >= len(vm.quads) || vm.engine.WindowClosed()
+}
 /*
 // NewVirtualMachine default
 func NewVirtualMachine() *VirtualMachine {
@@ -310,6 +522,19 @@ func NewVirtualMachine() *VirtualMachine {
 }
 */
 // NewVirtualMachine custom
-func NewVirtualMachine(quads []*quad.Quadruple, consmap map[string]int) *VirtualMachine {
-	return &VirtualMachine{0, quads, NewMemory(), ar.NewArStack(), ar.NewArStack(), engine.NewEngine("Ping Pong", 730, 500)}
+func NewVirtualMachine(quads []*quad.Quadruple, e engine.Engine) *VirtualMachine {
+	return &VirtualMachine{0, quads, NewMemory(), ar.NewArStack(), ar.NewArStack(), e, "", nil, make(map[mem.Address]string)}
+}
+
+// UsesGraphics checks if any of the quads calls a builtin that needs a window to draw
+// or read input from
+func UsesGraphics(quads []*quad.Quadruple) bool {
+	for _, q := range quads {
+		switch q.Op() {
+		case quad.Render, quad.Clear, quad.Update, quad.KeyPressed:
+			return true
+		}
+	}
+
+	return false
 }
\ No newline at end of file
@@ -0,0 +1,458 @@
+package vm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"hash/crc32"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sdkvictor/golang-compiler/ast"
+	"github.com/sdkvictor/golang-compiler/bytecode"
+	"github.com/sdkvictor/golang-compiler/directories"
+	"github.com/sdkvictor/golang-compiler/engine"
+	"github.com/sdkvictor/golang-compiler/gocc/lexer"
+	"github.com/sdkvictor/golang-compiler/gocc/parser"
+	"github.com/sdkvictor/golang-compiler/ic"
+	"github.com/sdkvictor/golang-compiler/mem"
+	"github.com/sdkvictor/golang-compiler/semantics"
+)
+
+var update = flag.Bool("update", false, "update the golden frames of the render tests")
+
+func compileFile(t *testing.T, test string) (*ic.Generator, *mem.VirtualMemory, *directories.FuncDirectory, *directories.VarDirectory) {
+	p := parser.NewParser()
+	input, err := readFile(test)
+	if err != nil {
+		t.Fatalf("Error reading file %s", test)
+	}
+
+	s := lexer.NewLexer(input)
+	pro, err := p.Parse(s)
+	if err != nil {
+		t.Fatalf("%s: %v", test, err)
+	}
+
+	program, ok := pro.(*ast.Program)
+	if !ok {
+		t.Fatalf("Cannot cast to Program")
+	}
+
+	funcdir, globals, err := semantics.SemanticCheck(program)
+	if err != nil {
+		t.Fatalf("Error from semantic: %v", err)
+	}
+
+	gen, vm, err := ic.GenerateIntermediateCode(program, funcdir, globals)
+	if err != nil {
+		t.Fatalf("Error from generate code: %v", err)
+	}
+
+	return gen, vm, funcdir, globals
+}
+
+// compileProgram compiles a file into the program written to a bytecode file
+func compileProgram(t *testing.T, test string) *bytecode.Program {
+	gen, memory, funcdir, globals := compileFile(t, test)
+
+	prog, err := bytecode.NewProgram(test, gen.Quadruples(), gen.Positions(), memory.GetConstantMap(), funcdir, globals)
+	if err != nil {
+		t.Fatalf("%s: %v", test, err)
+	}
+
+	return prog
+}
+
+// newMachine creates a headless virtual machine with the constants of the program loaded
+func newMachine(t *testing.T, prog *bytecode.Program) *VirtualMachine {
+	mach := NewVirtualMachine(prog.Quads, engine.NewHeadless(0))
+	if err := mach.LoadConstantPool(prog.Constants); err != nil {
+		t.Fatalf("%s: %v", prog.File, err)
+	}
+
+	return mach
+}
+
+func TestRenderGolden(t *testing.T) {
+	tests := []struct {
+		program string
+		input   string
+		frames  int
+	}{
+		{"../run/gameexamples/readmeexample.vm", "", 3},
+		{"../run/gameexamples/pong.vm", "", 3},
+		{"../run/gameexamples/readmeexample.vm", "test/readmeexample.input", 4},
+		{"../run/gameexamples/pong.vm", "test/pong.input", 4},
+	}
+
+	for _, test := range tests {
+		gen, memory, _, _ := compileFile(t, test.program)
+		consmap := memory.GetConstantMap()
+
+		dir := t.TempDir()
+		offscreen := engine.NewOffscreen(730, 500, test.frames, dir)
+		var e engine.Engine = offscreen
+
+		name := strings.TrimSuffix(filepath.Base(test.program), ".vm")
+		if test.input != "" {
+			script, err := engine.LoadScript(test.input)
+			if err != nil {
+				t.Fatalf("%s: %v", test.input, err)
+			}
+
+			e = engine.NewPlayback(offscreen, script)
+			name = strings.TrimSuffix(filepath.Base(test.input), ".input") + "_input"
+		}
+
+		mach := NewVirtualMachine(gen.Quadruples(), e)
+		if err := mach.LoadConstants(consmap); err != nil {
+			t.Fatalf("%s: %v", test.program, err)
+		}
+
+		if err := mach.Run(); err != nil {
+			t.Fatalf("%s: %v", test.program, err)
+		}
+
+		if err := offscreen.Err(); err != nil {
+			t.Fatalf("%s: %v", test.program, err)
+		}
+
+		for i := 0; i < offscreen.Frame(); i++ {
+			frame, err := engine.ReadPNG(offscreen.FramePath(i))
+			if err != nil {
+				t.Fatalf("%s: %v", test.program, err)
+			}
+
+			golden := filepath.Join("test", "golden", name, fmt.Sprintf("frame%05d.png", i))
+			if err := engine.CompareGolden(frame, golden, *update); err != nil {
+				t.Errorf("%s: %v", test.program, err)
+			}
+		}
+	}
+}
+
+func TestRecordSession(t *testing.T) {
+	tests := []struct {
+		program string
+		input   string
+	}{
+		{"../run/gameexamples/pong.vm", "test/pong.input"},
+		{"../run/gameexamples/readmeexample.vm", "test/readmeexample.input"},
+	}
+
+	for _, test := range tests {
+		script, err := engine.LoadScript(test.input)
+		if err != nil {
+			t.Fatalf("%s: %v", test.input, err)
+		}
+
+		gen, memory, _, _ := compileFile(t, test.program)
+		consmap := memory.GetConstantMap()
+
+		session := filepath.Join(t.TempDir(), "session.input")
+		rec, err := engine.NewRecorder(engine.NewPlayback(engine.NewHeadless(6), script), session)
+		if err != nil {
+			t.Fatalf("%s: %v", test.input, err)
+		}
+
+		mach := NewVirtualMachine(gen.Quadruples(), rec)
+		if err := mach.LoadConstants(consmap); err != nil {
+			t.Fatalf("%s: %v", test.program, err)
+		}
+
+		if err := mach.Run(); err != nil {
+			t.Fatalf("%s: %v", test.program, err)
+		}
+
+		if err := rec.Close(); err != nil {
+			t.Fatalf("%s: %v", test.input, err)
+		}
+
+		replay, err := engine.LoadScript(session)
+		if err != nil {
+			t.Fatalf("%s: %v", session, err)
+		}
+
+		if !reflect.DeepEqual(script.Events(), replay.Events()) {
+			t.Errorf("%s: Recorded session %v does not match %v", test.input, replay.Events(), script.Events())
+		}
+	}
+}
+
+func TestLoadObject(t *testing.T) {
+	tests := []string{
+		"../run/gameexamples/pong.vm",
+		"../run/gameexamples/readmeexample.vm",
+	}
+
+	for _, test := range tests {
+		gen, memory, _, _ := compileFile(t, test)
+
+		name := filepath.Join(t.TempDir(), "program")
+		if err := gen.CreateFile(name, memory); err != nil {
+			t.Fatalf("%s: %v", test, err)
+		}
+
+		prog, err := LoadProgram(name + ".obj")
+		if err != nil {
+			t.Fatalf("%s: %v", test, err)
+		}
+
+		pool, err := bytecode.NewConstantPool(memory.GetConstantMap())
+		if err != nil {
+			t.Fatalf("%s: %v", test, err)
+		}
+
+		if !reflect.DeepEqual(prog.Quads, gen.Quadruples()) {
+			t.Errorf("%s: Loaded quads do not match the generated ones", test)
+		}
+
+		if !reflect.DeepEqual(prog.Constants, pool) {
+			t.Errorf("%s: Loaded constants %v do not match %v", test, prog.Constants, pool)
+		}
+	}
+}
+
+func TestBytecode(t *testing.T) {
+	tests := []string{
+		"../run/gameexamples/pong.vm",
+		"../run/gameexamples/readmeexample.vm",
+	}
+
+	for _, test := range tests {
+		prog := compileProgram(t, test)
+
+		path := filepath.Join(t.TempDir(), "program.obj")
+		if err := bytecode.WriteFile(path, prog); err != nil {
+			t.Fatalf("%s: %v", test, err)
+		}
+
+		loaded, err := LoadProgram(path)
+		if err != nil {
+			t.Fatalf("%s: %v", test, err)
+		}
+
+		if !reflect.DeepEqual(prog, loaded) {
+			t.Errorf("%s: Loaded program does not match the generated one", test)
+		}
+
+		data, err := bytecode.Encode(prog)
+		if err != nil {
+			t.Fatalf("%s: %v", test, err)
+		}
+
+		// Any change to the file must be rejected, including a different version
+		data[len(bytecode.Magic)]++
+		if _, err := bytecode.Decode(data); err == nil || !strings.Contains(err.Error(), "version") {
+			t.Errorf("%s: Expected a version error, got %v", test, err)
+		}
+		data[len(bytecode.Magic)]--
+
+		// Version 4 stored the strings with their quotes, a valid file of it must still be rejected
+		old := append([]byte(nil), data...)
+		binary.LittleEndian.PutUint16(old[len(bytecode.Magic):], 4)
+		binary.LittleEndian.PutUint32(old[len(old)-4:], crc32.ChecksumIEEE(old[:len(old)-4]))
+		if _, err := bytecode.Decode(old); err == nil || !strings.Contains(err.Error(), "version 4") {
+			t.Errorf("%s: Expected a version error, got %v", test, err)
+		}
+
+		data[len(data)/2]++
+		if _, err := bytecode.Decode(data); err == nil || !strings.Contains(err.Error(), "checksum") {
+			t.Errorf("%s: Expected a checksum error, got %v", test, err)
+		}
+	}
+}
+
+func TestDisassemble(t *testing.T) {
+	tests := []struct {
+		program string
+		expects []string
+	}{
+		{"../run/gameexamples/readmeexample.vm", []string{"\nmain:\n", "\ntick:\n", "Call            tick", "KeyPressed      \"W\", _, temp.bool[0]", "global.Square[0].x"}},
+		{"../run/gameexamples/pong.vm", []string{"\nmain:\n", "Goto            _, _, main", "GotoF           temp.bool[0], _, L"}},
+	}
+
+	for _, test := range tests {
+		prog := compileProgram(t, test.program)
+
+		listing := bytecode.Disassemble(prog)
+		for _, e := range test.expects {
+			if !strings.Contains(listing, e) {
+				t.Errorf("%s: Expected %q in the disassembly", test.program, e)
+			}
+		}
+	}
+}
+
+func TestDebugger(t *testing.T) {
+	tests := []struct {
+		program  string
+		commands string
+		expects  []string
+	}{
+		{"test/debug.vm", "break fact\ncontinue\nbacktrace\nprint n\nprint total\n", []string{
+			"Stopped in main, line 16", "Breakpoint fact: fact, line 9", "#0  fact, line 9\n#1  main, line 20", "n = 1", "total = 0",
+		}},
+		{"test/debug.vm", "break 22\nc\nlocals\nprint values\nnext\nnext\nstep\nstep\nstep\n", []string{
+			"Breakpoint 22: main, line 22", "i = 0", "values = [1, 0, 0]", "Stopped in main, line 19", "Stopped in main, line 20",
+			"Stopped in fact, line 9", "Stopped in fact, line 12",
+		}},
+		{"test/debug.vm", "break 3\nbreak nothing\nprint nothing\nd fact\nc\n", []string{
+			"Line 3 has no code", "Function nothing does not exist", "Variable nothing does not exist", "No breakpoint fact", "Program finished",
+		}},
+	}
+
+	for _, test := range tests {
+		prog := compileProgram(t, test.program)
+
+		source, err := readFile(test.program)
+		if err != nil {
+			t.Fatalf("%s: %v", test.program, err)
+		}
+
+		var out bytes.Buffer
+		d, err := NewDebugger(prog, source, engine.NewHeadless(0), strings.NewReader(test.commands), &out)
+		if err != nil {
+			t.Fatalf("%s: %v", test.program, err)
+		}
+
+		if err := d.Run(); err != nil {
+			t.Fatalf("%s: %v", test.program, err)
+		}
+
+		for _, e := range test.expects {
+			if !strings.Contains(out.String(), e) {
+				t.Errorf("%s: Expected %q in the output of %q, got:\n%s", test.program, e, test.commands, out.String())
+			}
+		}
+	}
+}
+
+func TestRuntimeError(t *testing.T) {
+	tests := []struct {
+		program string
+		expects string
+	}{
+		{"test/outofbounds.vm", "test/outofbounds.vm:11:9: Index 3 out of bounds for array of size 3\n" +
+			"    at fill (test/outofbounds.vm:11:9)\n" +
+			"    at main (test/outofbounds.vm:18:5)"},
+		{"test/divzero.vm", "test/divzero.vm:8:18: Division by zero\n" +
+			"    at average (test/divzero.vm:8:18)\n" +
+			"    at main (test/divzero.vm:13:13)"},
+		{"test/gridbounds.vm", "test/gridbounds.vm:10:9: Index 4 out of bounds for array of size 4\n" +
+			"    at main (test/gridbounds.vm:10:9)"},
+		{"test/listbounds.vm", "test/listbounds.vm:12:15: Index 2 out of bounds for list of size 2\n" +
+			"    at main (test/listbounds.vm:12:15)"},
+		{"test/strbounds.vm", "test/strbounds.vm:10:9: Index 3 out of bounds for string of length 3\n" +
+			"    at main (test/strbounds.vm:10:9)"},
+		{"test/badchar.vm", "test/badchar.vm:10:9: Cannot convert -1 to char, it is not a valid character code\n" +
+			"    at main (test/badchar.vm:10:9)"},
+	}
+
+	for _, test := range tests {
+		prog := compileProgram(t, test.program)
+
+		mach := newMachine(t, prog)
+		mach.LoadDebugInfo(prog)
+
+		err := mach.Run()
+		if err == nil {
+			t.Fatalf("%s: Expected a runtime error", test.program)
+		}
+
+		if err.Error() != test.expects {
+			t.Errorf("%s: Expected error %q, got %q", test.program, test.expects, err.Error())
+		}
+	}
+}
+
+func TestExpressions(t *testing.T) {
+	tests := []struct {
+		program string
+		expects map[string]string
+	}{
+		{"test/precedence.vm", map[string]string{
+			"sum": "14", "diff": "3", "quot": "2", "cmp": "true", "logic": "true", "mixed": "true",
+		}},
+		{"test/comparisons.vm", map[string]string{
+			"intLe": "true", "intGe": "false", "intNe": "true", "floatLe": "false", "floatGe": "true", "charLt": "true",
+			"charGe": "false", "strLt": "true", "strGt": "true", "strLe": "false", "strNe": "false", "strAlias": "true",
+			"boolNe": "true",
+		}},
+		{"test/unary.vm", map[string]string{
+			"neg": "-5", "twice": "5", "literal": "-6", "diff": "4", "negf": "-3", "notT": "false", "notCmp": "true",
+			"doubleNot": "true",
+		}},
+		{"test/arithmetic.vm", map[string]string{
+			"quot": "3", "negQuot": "-3", "rem": "1", "negRem": "-1", "wrap": "0", "fquot": "3.500000", "frem": "1.500000",
+		}},
+		{"test/loops.vm", map[string]string{"odds": "16", "count": "4", "pairs": "6"}},
+		{"test/switch.vm", map[string]string{"dir": "2", "score": "120", "total": "4022", "grade": "2"}},
+		{"test/structs.vm", map[string]string{
+			"p": "{1.500000, 2, 10}", "q": "{1.500000, 2, 20}", "moved": "{2.500000, 1, 10}", "before": "20",
+			"total": "6", "sumX": "3", "best": "4", "origin": "{0, 0}", "firstScore": "7", "elemX": "4",
+			"elemSum": "21", "squadScore": "15", "squadY": "9",
+		}},
+		{"test/classes.vm", map[string]string{
+			"total": "7", "px": "1.500000", "py": "2", "bumped": "11", "score": "6", "hitNear": "true",
+			"hitFar": "false", "hitBall": "true", "rosterX": "3.500000", "rosterScore": "5",
+		}},
+		{"test/grids.vm", map[string]string{
+			"total": "138", "corner": "23", "diagonal": "33", "cubeSum": "7", "last": "7", "cellSum": "12",
+		}},
+		{"test/lists.vm", map[string]string{
+			"count": "4", "sum": "39", "first": "10", "afterClear": "0", "numbers": "[]", "alive": "2",
+			"bullets": "[{0.500000, 2}, {0.500000, 3}]", "wallX": "3.500000",
+		}},
+		{"test/strings.vm", map[string]string{
+			"label": "Score 42", "size": "11", "first": "h", "last": "d", "part": "world", "at": "6",
+			"missing": "-1", "charAt": "6", "parsed": "54", "ratio": "5", "fromFloat": "1.500000",
+			"fromBool": "true", "fromChar": "h", "message": `Game over, press R! ("r": été)`, "quote": `"`,
+			"escapedLen": "6", "zero": "0", "zeroInt": "1",
+		}},
+		{"test/casts.vm", map[string]string{
+			"mixed": "13.500000", "ratio": "3.500000", "promoted": "4", "half": "1.500000", "avg": "2.500000",
+			"whole": "3", "negWhole": "-3", "code": "65", "letter": "B", "next": "C", "digit": "7", "count": "3",
+			"less": "true", "same": "true",
+		}},
+		{"test/constants.vm", map[string]string{
+			"SPEED": "10", "HALF": "2.500000", "NAME": "player", "LETTER": "D", "FAST": "true", "score": "6",
+			"start": "1", "local": "4", "moved": "8", "wallX": "10", "wallY": "20", "ballX": "2.500000",
+			"ballSize": "3", "emptyX": "0", "resetY": "0",
+		}},
+		{"test/compound.vm", map[string]string{
+			"total": "30", "calls": "3", "first": "5", "second": "11", "ratio": "0.750000", "rem": "1",
+			"squareX": "6", "lives": "2", "playerX": "3.500000", "numbers": "12,6", "label": "hi there",
+		}},
+	}
+
+	for _, test := range tests {
+		prog := compileProgram(t, test.program)
+
+		mach := newMachine(t, prog)
+
+		if err := mach.Run(); err != nil {
+			t.Fatalf("%s: %v", test.program, err)
+		}
+
+		for _, sym := range prog.Symbols {
+			expected, ok := test.expects[sym.Name]
+			if !ok {
+				continue
+			}
+
+			v, err := mach.mm.GetValue(sym.Addr)
+			if err != nil {
+				t.Fatalf("%s: %v", test.program, err)
+			}
+
+			if got := formatValue(v); got != expected {
+				t.Errorf("%s: Expected %s = %s, got %s", test.program, sym.Name, expected, got)
+			}
+		}
+	}
+}